@@ -25,6 +25,7 @@ type EthClient interface {
 	BlockByHash(context.Context, common.Hash) (*types.Block, error)
 	BlockNumber(context.Context) (uint64, error)
 	CallContract(context.Context, interfaces.CallMsg, *big.Int) ([]byte, error)
+	ChainID(context.Context) (*big.Int, error)
 	NonceAt(context.Context, common.Address, *big.Int) (uint64, error)
 	AssetBalanceAt(context.Context, common.Address, ids.ID, *big.Int) (*big.Int, error)
 	SuggestGasPrice(context.Context) (*big.Int, error)
@@ -152,6 +153,15 @@ func (c *ethClient) CallContract(ctx context.Context, msg interfaces.CallMsg, bl
 	return c.client.CallContract(ctx, msg, blockNumber)
 }
 
+func (c *ethClient) ChainID(ctx context.Context) (*big.Int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	return c.client.ChainID(ctx)
+}
+
 func (c *ethClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -0,0 +1,371 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package chaos implements a scheduler of random node disruptions over a live
+// network. Given the same policy and seed, the same disruptions are executed
+// in the same order.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"go.uber.org/zap"
+)
+
+const (
+	// Max time given to an action on the target network.
+	actionTimeout = 2 * time.Minute
+
+	defaultMinInterval = 30 * time.Second
+	defaultMaxInterval = 2 * time.Minute
+	defaultMinDowntime = 10 * time.Second
+	defaultMaxDowntime = time.Minute
+)
+
+var (
+	ErrNoEligibleNodes     = errors.New("no eligible nodes")
+	ErrUnknownNode         = errors.New("unknown node")
+	ErrInvalidDisruption   = errors.New("invalid disruption type")
+	ErrInvalidDistribution = errors.New("invalid interval distribution")
+	ErrInvalidRange        = errors.New("min value is greater than max value")
+)
+
+type Action string
+
+const (
+	// Pauses a node, and resumes it after a random downtime.
+	Pause Action = "pause"
+	// Resumes a node previously paused by the scheduler.
+	// It is not a valid disruption.
+	Resume  Action = "resume"
+	Restart Action = "restart"
+	// Removes a node for good.
+	Remove Action = "remove"
+)
+
+type Distribution string
+
+const (
+	// Intervals are drawn uniformly in [MinInterval, MaxInterval].
+	Uniform Distribution = "uniform"
+	// Intervals are MinInterval plus an exponentially distributed time
+	// with mean MaxInterval - MinInterval, so they are not upper bounded.
+	Exponential Distribution = "exponential"
+)
+
+// Policy defines which disruptions are executed, on which nodes, and how often.
+type Policy struct {
+	// Nodes that may be disrupted. Empty means all the nodes of the network.
+	Nodes []string
+	// Max number of nodes paused or removed at the same time. Defaults to 1.
+	MaxDown int
+	// Distribution of the intervals between disruptions. Defaults to [Uniform].
+	Distribution Distribution
+	MinInterval  time.Duration
+	MaxInterval  time.Duration
+	// Disruption types to choose from. Defaults to [Pause].
+	Disruptions []Action
+	// Range of the time a paused node stays down.
+	MinDowntime time.Duration
+	MaxDowntime time.Duration
+	// Seed of the random choices. If zero, a random seed is used.
+	Seed int64
+	// Time after which no more disruptions are executed. Zero means
+	// until the scheduler is stopped.
+	Duration time.Duration
+}
+
+// Sets the defaults for the unset fields of [p], and validates it
+// against the [nodeNames] of the network.
+func (p *Policy) complete(nodeNames []string) error {
+	if len(p.Nodes) == 0 {
+		p.Nodes = append([]string{}, nodeNames...)
+	}
+	if len(p.Nodes) == 0 {
+		return ErrNoEligibleNodes
+	}
+	networkNodes := set.Set[string]{}
+	networkNodes.Add(nodeNames...)
+	for _, nodeName := range p.Nodes {
+		if !networkNodes.Contains(nodeName) {
+			return fmt.Errorf("%w: %q", ErrUnknownNode, nodeName)
+		}
+	}
+	// choices must not depend on the input order
+	sort.Strings(p.Nodes)
+
+	if p.MaxDown <= 0 {
+		p.MaxDown = 1
+	}
+	if p.Distribution == "" {
+		p.Distribution = Uniform
+	}
+	if p.Distribution != Uniform && p.Distribution != Exponential {
+		return fmt.Errorf("%w: %q", ErrInvalidDistribution, p.Distribution)
+	}
+	if p.MinInterval == 0 && p.MaxInterval == 0 {
+		p.MinInterval = defaultMinInterval
+		p.MaxInterval = defaultMaxInterval
+	}
+	if p.MinInterval > p.MaxInterval {
+		return fmt.Errorf("%w: interval", ErrInvalidRange)
+	}
+	if len(p.Disruptions) == 0 {
+		p.Disruptions = []Action{Pause}
+	}
+	for _, disruption := range p.Disruptions {
+		if disruption != Pause && disruption != Restart && disruption != Remove {
+			return fmt.Errorf("%w: %q", ErrInvalidDisruption, disruption)
+		}
+	}
+	if p.MinDowntime == 0 && p.MaxDowntime == 0 {
+		p.MinDowntime = defaultMinDowntime
+		p.MaxDowntime = defaultMaxDowntime
+	}
+	if p.MinDowntime > p.MaxDowntime {
+		return fmt.Errorf("%w: downtime", ErrInvalidRange)
+	}
+	if p.Seed == 0 {
+		p.Seed = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// Target is the network the disruptions are executed on.
+type Target interface {
+	PauseNode(ctx context.Context, nodeName string) error
+	ResumeNode(ctx context.Context, nodeName string) error
+	RestartNode(ctx context.Context, nodeName string) error
+	RemoveNode(ctx context.Context, nodeName string) error
+}
+
+// Event is an action executed by the scheduler.
+type Event struct {
+	Time     time.Time
+	Action   Action
+	NodeName string
+	// Set if the action failed
+	Err error
+}
+
+// Scheduler executes random disruptions over a target network.
+type Scheduler struct {
+	log    logging.Logger
+	policy Policy
+	target Target
+	rand   *rand.Rand
+
+	lock     sync.Mutex
+	timeline []Event
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Closed when the scheduler is done
+	doneCh chan struct{}
+}
+
+// New returns a scheduler of the disruptions given by [policy] over [target],
+// whose nodes are [nodeNames].
+func New(log logging.Logger, policy Policy, target Target, nodeNames []string) (*Scheduler, error) {
+	if err := policy.complete(nodeNames); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		log:    log,
+		policy: policy,
+		target: target,
+		rand:   rand.New(rand.NewSource(policy.Seed)), //nolint:gosec
+		ctx:    ctx,
+		cancel: cancel,
+		doneCh: make(chan struct{}),
+	}, nil
+}
+
+// Returns the policy of the scheduler, with the defaults applied.
+func (s *Scheduler) Policy() Policy {
+	return s.policy
+}
+
+// Starts executing disruptions in the background.
+func (s *Scheduler) Start() {
+	s.log.Info("starting chaos", zap.Int64("seed", s.policy.Seed), zap.Strings("nodes", s.policy.Nodes))
+	go s.run()
+}
+
+// Stops the scheduler without waiting for it to finish.
+func (s *Scheduler) Cancel() {
+	s.cancel()
+}
+
+// Stops the scheduler, and waits until the nodes it paused are resumed.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	<-s.doneCh
+}
+
+// Returns true if the scheduler has not finished yet.
+func (s *Scheduler) Running() bool {
+	select {
+	case <-s.doneCh:
+		return false
+	default:
+		return true
+	}
+}
+
+// Returns the actions executed so far.
+func (s *Scheduler) Timeline() []Event {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]Event{}, s.timeline...)
+}
+
+// A pending resume of a paused node.
+type resume struct {
+	at       time.Duration
+	nodeName string
+}
+
+// Disruptions and resumes are laid out on a virtual timeline, computed only
+// from the random draws, and executed in that order. Actions are delayed
+// until their time on the timeline, but not skipped if late, so the sequence
+// of actions only depends on the seed.
+func (s *Scheduler) run() {
+	defer close(s.doneCh)
+
+	start := time.Now()
+	paused := set.Set[string]{}
+	removed := set.Set[string]{}
+	resumes := []resume{}
+	nextDisruption := s.drawInterval()
+
+	for {
+		// resumes go first on ties
+		nextResume := time.Duration(-1)
+		if len(resumes) > 0 {
+			nextResume = resumes[0].at
+		}
+		isResume := nextResume >= 0 && nextResume <= nextDisruption
+		at := nextDisruption
+		if isResume {
+			at = nextResume
+		}
+		if !isResume && s.policy.Duration > 0 && at > s.policy.Duration {
+			if len(resumes) == 0 {
+				break
+			}
+			// no more disruptions, but pending resumes still follow the timeline
+			isResume = true
+			at = nextResume
+		}
+
+		select {
+		case <-s.ctx.Done():
+		case <-time.After(time.Until(start.Add(at))):
+		}
+		if s.ctx.Err() != nil {
+			break
+		}
+
+		if isResume {
+			nodeName := resumes[0].nodeName
+			resumes = resumes[1:]
+			paused.Remove(nodeName)
+			s.execute(Resume, nodeName)
+			continue
+		}
+
+		nextDisruption += s.drawInterval()
+
+		candidates := []string{}
+		for _, nodeName := range s.policy.Nodes {
+			if !paused.Contains(nodeName) && !removed.Contains(nodeName) {
+				candidates = append(candidates, nodeName)
+			}
+		}
+		if paused.Len()+removed.Len() >= s.policy.MaxDown || len(candidates) == 0 {
+			continue
+		}
+		action := s.policy.Disruptions[s.rand.Intn(len(s.policy.Disruptions))]
+		nodeName := candidates[s.rand.Intn(len(candidates))]
+		downtime := s.drawDuration(s.policy.MinDowntime, s.policy.MaxDowntime)
+		if err := s.execute(action, nodeName); err != nil {
+			continue
+		}
+		switch action {
+		case Pause:
+			paused.Add(nodeName)
+			resumes = append(resumes, resume{at: at + downtime, nodeName: nodeName})
+			sort.SliceStable(resumes, func(i, j int) bool {
+				return resumes[i].at < resumes[j].at
+			})
+		case Remove:
+			removed.Add(nodeName)
+		}
+	}
+
+	// leave the network as found, except for the removed nodes
+	for _, r := range resumes {
+		s.execute(Resume, r.nodeName)
+	}
+	s.log.Info("chaos finished", zap.Int("num-events", len(s.Timeline())))
+}
+
+// Executes [action] over [nodeName] and records it on the timeline.
+func (s *Scheduler) execute(action Action, nodeName string) error {
+	s.log.Info("chaos action", zap.String("action", string(action)), zap.String("node-name", nodeName))
+
+	ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+	defer cancel()
+
+	var err error
+	switch action {
+	case Pause:
+		err = s.target.PauseNode(ctx, nodeName)
+	case Resume:
+		err = s.target.ResumeNode(ctx, nodeName)
+	case Restart:
+		err = s.target.RestartNode(ctx, nodeName)
+	case Remove:
+		err = s.target.RemoveNode(ctx, nodeName)
+	}
+	if err != nil {
+		s.log.Warn("chaos action failed",
+			zap.String("action", string(action)),
+			zap.String("node-name", nodeName),
+			zap.Error(err),
+		)
+	}
+
+	s.lock.Lock()
+	s.timeline = append(s.timeline, Event{
+		Time:     time.Now(),
+		Action:   action,
+		NodeName: nodeName,
+		Err:      err,
+	})
+	s.lock.Unlock()
+	return err
+}
+
+func (s *Scheduler) drawInterval() time.Duration {
+	if s.policy.Distribution == Exponential {
+		return s.policy.MinInterval + time.Duration(s.rand.ExpFloat64()*float64(s.policy.MaxInterval-s.policy.MinInterval))
+	}
+	return s.drawDuration(s.policy.MinInterval, s.policy.MaxInterval)
+}
+
+// Draws a duration uniformly in [min, max].
+func (s *Scheduler) drawDuration(min time.Duration, max time.Duration) time.Duration {
+	return min + time.Duration(s.rand.Int63n(int64(max-min)+1))
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test error")
+
+// fakeTarget records the actions executed over it, and checks the number
+// of nodes down at the same time.
+type fakeTarget struct {
+	lock    sync.Mutex
+	down    map[string]bool
+	maxDown int
+	failOn  string
+}
+
+func newFakeTarget() *fakeTarget {
+	return &fakeTarget{down: map[string]bool{}}
+}
+
+func (f *fakeTarget) setDown(nodeName string, down bool) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if nodeName == f.failOn {
+		return errTest
+	}
+	if down {
+		f.down[nodeName] = true
+	} else {
+		delete(f.down, nodeName)
+	}
+	if len(f.down) > f.maxDown {
+		f.maxDown = len(f.down)
+	}
+	return nil
+}
+
+func (f *fakeTarget) PauseNode(_ context.Context, nodeName string) error {
+	return f.setDown(nodeName, true)
+}
+
+func (f *fakeTarget) ResumeNode(_ context.Context, nodeName string) error {
+	return f.setDown(nodeName, false)
+}
+
+func (*fakeTarget) RestartNode(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeTarget) RemoveNode(_ context.Context, nodeName string) error {
+	return f.setDown(nodeName, true)
+}
+
+var testNodeNames = []string{"node1", "node2", "node3", "node4", "node5"}
+
+func testPolicy() Policy {
+	return Policy{
+		MaxDown:     2,
+		MinInterval: time.Millisecond,
+		MaxInterval: 3 * time.Millisecond,
+		Disruptions: []Action{Pause, Restart},
+		MinDowntime: time.Millisecond,
+		MaxDowntime: 10 * time.Millisecond,
+		Seed:        42,
+		Duration:    200 * time.Millisecond,
+	}
+}
+
+func runToEnd(t *testing.T, policy Policy, target Target) []Event {
+	s, err := New(logging.NoLog{}, policy, target, testNodeNames)
+	require.NoError(t, err)
+	s.Start()
+	<-s.doneCh
+	require.False(t, s.Running())
+	return s.Timeline()
+}
+
+func eventsToStrings(events []Event) []string {
+	strs := make([]string, 0, len(events))
+	for _, event := range events {
+		strs = append(strs, fmt.Sprintf("%s %s %v", event.Action, event.NodeName, event.Err))
+	}
+	return strs
+}
+
+func TestPolicyDefaults(t *testing.T) {
+	require := require.New(t)
+
+	policy := Policy{}
+	require.NoError(policy.complete([]string{"node2", "node1"}))
+	require.Equal([]string{"node1", "node2"}, policy.Nodes)
+	require.Equal(1, policy.MaxDown)
+	require.Equal(Uniform, policy.Distribution)
+	require.Equal([]Action{Pause}, policy.Disruptions)
+	require.Equal(defaultMinInterval, policy.MinInterval)
+	require.Equal(defaultMaxDowntime, policy.MaxDowntime)
+	require.NotZero(policy.Seed)
+}
+
+func TestPolicyErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      Policy
+		nodeNames   []string
+		expectedErr error
+	}{
+		{
+			name:        "no nodes",
+			expectedErr: ErrNoEligibleNodes,
+		},
+		{
+			name:        "unknown node",
+			policy:      Policy{Nodes: []string{"node2"}},
+			nodeNames:   []string{"node1"},
+			expectedErr: ErrUnknownNode,
+		},
+		{
+			name:        "resume disruption",
+			policy:      Policy{Disruptions: []Action{Resume}},
+			nodeNames:   []string{"node1"},
+			expectedErr: ErrInvalidDisruption,
+		},
+		{
+			name:        "invalid distribution",
+			policy:      Policy{Distribution: "normal"},
+			nodeNames:   []string{"node1"},
+			expectedErr: ErrInvalidDistribution,
+		},
+		{
+			name:        "invalid interval",
+			policy:      Policy{MinInterval: time.Minute, MaxInterval: time.Second},
+			nodeNames:   []string{"node1"},
+			expectedErr: ErrInvalidRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.policy.complete(tt.nodeNames), tt.expectedErr)
+		})
+	}
+}
+
+func TestSchedulerReproducible(t *testing.T) {
+	require := require.New(t)
+
+	target := newFakeTarget()
+	timeline := runToEnd(t, testPolicy(), target)
+	require.NotEmpty(timeline)
+	require.LessOrEqual(target.maxDown, 2)
+	// all paused nodes are resumed at the end
+	require.Empty(target.down)
+
+	otherTimeline := runToEnd(t, testPolicy(), newFakeTarget())
+	require.Equal(eventsToStrings(timeline), eventsToStrings(otherTimeline))
+
+	policy := testPolicy()
+	policy.Seed = 43
+	otherTimeline = runToEnd(t, policy, newFakeTarget())
+	require.NotEqual(eventsToStrings(timeline), eventsToStrings(otherTimeline))
+}
+
+func TestSchedulerRemove(t *testing.T) {
+	require := require.New(t)
+
+	policy := testPolicy()
+	policy.Disruptions = []Action{Remove}
+	policy.Nodes = []string{"node1", "node2", "node3"}
+	target := newFakeTarget()
+	timeline := runToEnd(t, policy, target)
+
+	// removed nodes count as down for good
+	require.Len(timeline, 2)
+	require.Len(target.down, 2)
+	for _, event := range timeline {
+		require.Equal(Remove, event.Action)
+		require.Contains(policy.Nodes, event.NodeName)
+	}
+}
+
+func TestSchedulerFailedAction(t *testing.T) {
+	require := require.New(t)
+
+	policy := testPolicy()
+	policy.Nodes = []string{"node1"}
+	policy.Disruptions = []Action{Pause}
+	target := newFakeTarget()
+	target.failOn = "node1"
+	timeline := runToEnd(t, policy, target)
+
+	require.NotEmpty(timeline)
+	for _, event := range timeline {
+		// failed pauses are not followed by resumes
+		require.Equal(Pause, event.Action)
+		require.ErrorIs(event.Err, errTest)
+	}
+}
+
+func TestSchedulerStop(t *testing.T) {
+	require := require.New(t)
+
+	policy := testPolicy()
+	policy.Duration = 0
+	policy.MinDowntime = time.Hour
+	policy.MaxDowntime = time.Hour
+	policy.Disruptions = []Action{Pause}
+	target := newFakeTarget()
+	s, err := New(logging.NoLog{}, policy, target, testNodeNames)
+	require.NoError(err)
+	s.Start()
+
+	require.Eventually(func() bool {
+		return len(s.Timeline()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Stop()
+	require.False(s.Running())
+
+	// the paused nodes are resumed on stop
+	timeline := s.Timeline()
+	require.Len(timeline, 4)
+	require.Equal(Resume, timeline[2].Action)
+	require.Equal(Resume, timeline[3].Action)
+	require.Empty(target.down)
+}
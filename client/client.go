@@ -25,6 +25,9 @@ import (
 type Config struct {
 	Endpoint    string
 	DialTimeout time.Duration
+	// Name of the server network all the requests operate on.
+	// If empty, the server default network is used.
+	NetworkName string
 }
 
 type Client interface {
@@ -35,6 +38,8 @@ type Client interface {
 	CreateSubnets(ctx context.Context, subnetSpecs []*rpcpb.SubnetSpec) (*rpcpb.CreateSubnetsResponse, error)
 	TransformElasticSubnets(ctx context.Context, elasticSubnetSpecs []*rpcpb.ElasticSubnetSpec) (*rpcpb.TransformElasticSubnetsResponse, error)
 	AddPermissionlessValidator(ctx context.Context, validatorSpec []*rpcpb.PermissionlessValidatorSpec) (*rpcpb.AddPermissionlessValidatorResponse, error)
+	AddPermissionlessDelegator(ctx context.Context, delegatorSpec []*rpcpb.PermissionlessDelegatorSpec) (*rpcpb.AddPermissionlessDelegatorResponse, error)
+	AddPrimaryDelegator(ctx context.Context, delegatorSpec []*rpcpb.PrimaryDelegatorSpec) (*rpcpb.AddPrimaryDelegatorResponse, error)
 	RemoveSubnetValidator(ctx context.Context, validatorSpec []*rpcpb.RemoveSubnetValidatorSpec) (*rpcpb.RemoveSubnetValidatorResponse, error)
 	Health(ctx context.Context) (*rpcpb.HealthResponse, error)
 	WaitForHealthy(ctx context.Context) (*rpcpb.WaitForHealthyResponse, error)
@@ -50,12 +55,35 @@ type Client interface {
 	AttachPeer(ctx context.Context, nodeName string) (*rpcpb.AttachPeerResponse, error)
 	SendOutboundMessage(ctx context.Context, nodeName string, peerID string, op uint32, msgBody []byte) (*rpcpb.SendOutboundMessageResponse, error)
 	Close() error
-	SaveSnapshot(ctx context.Context, snapshotName string) (*rpcpb.SaveSnapshotResponse, error)
+	SaveSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.SaveSnapshotResponse, error)
 	LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error)
 	RemoveSnapshot(ctx context.Context, snapshotName string) (*rpcpb.RemoveSnapshotResponse, error)
 	GetSnapshotNames(ctx context.Context) ([]string, error)
+	GetSnapshotInfo(ctx context.Context, snapshotName string) (*rpcpb.SnapshotInfo, error)
+	ListSnapshots(ctx context.Context, labels map[string]string) ([]*rpcpb.SnapshotInfo, error)
+	ListNetworks(ctx context.Context) (*rpcpb.ListNetworksResponse, error)
+	ApplyManifest(ctx context.Context, manifest string, opts ...OpOption) (*rpcpb.ApplyManifestResponse, error)
+	PartitionNodes(ctx context.Context, groups [][]string) (*rpcpb.PartitionNodesResponse, error)
+	HealPartitions(ctx context.Context) (*rpcpb.HealPartitionsResponse, error)
+	SetLinkLatency(ctx context.Context, from string, to string, delay time.Duration, jitter time.Duration) (*rpcpb.SetLinkLatencyResponse, error)
+	SetLinkLoss(ctx context.Context, from string, to string, loss float64) (*rpcpb.SetLinkLossResponse, error)
+	StartChaos(ctx context.Context, policy *rpcpb.ChaosPolicy) (*rpcpb.StartChaosResponse, error)
+	StopChaos(ctx context.Context) (*rpcpb.StopChaosResponse, error)
+	GetChaosTimeline(ctx context.Context) (*rpcpb.GetChaosTimelineResponse, error)
+	StreamEvents(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.StreamEventsResponse, error)
+	GetNodeLogs(ctx context.Context, nodeNames []string, filter *rpcpb.LogFilter, offset uint32, limit uint32) (*rpcpb.GetNodeLogsResponse, error)
+	TailLogs(ctx context.Context, nodeNames []string, filter *rpcpb.LogFilter, lines uint32) (<-chan *rpcpb.LogEntry, error)
+	ExportSnapshot(ctx context.Context, snapshotName string, w io.Writer) error
+	ImportSnapshot(ctx context.Context, snapshotName string, r io.Reader) (*rpcpb.ImportSnapshotResponse, error)
+	Prune(ctx context.Context, opts ...OpOption) (*rpcpb.PruneResponse, error)
+	MigrateSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.MigrateSnapshotResponse, error)
+	Fund(ctx context.Context, address string, amount uint64, opts ...OpOption) (*rpcpb.FundResponse, error)
+	CrossChainTransfer(ctx context.Context, sourceChain string, destinationChain string, amount uint64, opts ...OpOption) (*rpcpb.CrossChainTransferResponse, error)
 }
 
+// Max size of the archive chunks sent on snapshot import
+const snapshotArchiveChunkSize = 1024 * 1024
+
 type client struct {
 	cfg Config
 	log logging.Logger
@@ -117,6 +145,7 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 		ChainConfigs:   ret.chainConfigs,
 		UpgradeConfigs: ret.upgradeConfigs,
 		SubnetConfigs:  ret.subnetConfigs,
+		NetworkName:    c.cfg.NetworkName,
 	}
 	if ret.trackSubnets != "" {
 		req.WhitelistedSubnets = &ret.trackSubnets
@@ -138,6 +167,12 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 	}
 	req.ReassignPortsIfUsed = &ret.reassignPortsIfUsed
 	req.DynamicPorts = &ret.dynamicPorts
+	req.FaultInjection = &ret.faultInjection
+	req.RestartPolicy = ret.restartPolicy
+	req.GenesisSpec = ret.genesisSpec
+	req.KeySeed = ret.keySeed
+	req.NumTestAccounts = ret.numTestAccounts
+	req.TestAccountBalance = ret.testAccountBalance
 
 	c.log.Info("start")
 	return c.controlc.Start(ctx, req)
@@ -146,6 +181,7 @@ func (c *client) Start(ctx context.Context, execPath string, opts ...OpOption) (
 func (c *client) CreateBlockchains(ctx context.Context, blockchainSpecs []*rpcpb.BlockchainSpec) (*rpcpb.CreateBlockchainsResponse, error) {
 	req := &rpcpb.CreateBlockchainsRequest{
 		BlockchainSpecs: blockchainSpecs,
+		NetworkName:     c.cfg.NetworkName,
 	}
 
 	c.log.Info("create blockchains")
@@ -155,6 +191,7 @@ func (c *client) CreateBlockchains(ctx context.Context, blockchainSpecs []*rpcpb
 func (c *client) CreateSubnets(ctx context.Context, subnetSpecs []*rpcpb.SubnetSpec) (*rpcpb.CreateSubnetsResponse, error) {
 	req := &rpcpb.CreateSubnetsRequest{
 		SubnetSpecs: subnetSpecs,
+		NetworkName: c.cfg.NetworkName,
 	}
 
 	c.log.Info("create subnets")
@@ -164,6 +201,7 @@ func (c *client) CreateSubnets(ctx context.Context, subnetSpecs []*rpcpb.SubnetS
 func (c *client) TransformElasticSubnets(ctx context.Context, elasticSubnetSpecs []*rpcpb.ElasticSubnetSpec) (*rpcpb.TransformElasticSubnetsResponse, error) {
 	req := &rpcpb.TransformElasticSubnetsRequest{
 		ElasticSubnetSpec: elasticSubnetSpecs,
+		NetworkName:       c.cfg.NetworkName,
 	}
 
 	c.log.Info("transform subnets")
@@ -173,15 +211,37 @@ func (c *client) TransformElasticSubnets(ctx context.Context, elasticSubnetSpecs
 func (c *client) AddPermissionlessValidator(ctx context.Context, validatorSpec []*rpcpb.PermissionlessValidatorSpec) (*rpcpb.AddPermissionlessValidatorResponse, error) {
 	req := &rpcpb.AddPermissionlessValidatorRequest{
 		ValidatorSpec: validatorSpec,
+		NetworkName:   c.cfg.NetworkName,
 	}
 
 	c.log.Info("add permissionless validators to elastic subnets")
 	return c.controlc.AddPermissionlessValidator(ctx, req)
 }
 
+func (c *client) AddPermissionlessDelegator(ctx context.Context, delegatorSpec []*rpcpb.PermissionlessDelegatorSpec) (*rpcpb.AddPermissionlessDelegatorResponse, error) {
+	req := &rpcpb.AddPermissionlessDelegatorRequest{
+		DelegatorSpec: delegatorSpec,
+		NetworkName:   c.cfg.NetworkName,
+	}
+
+	c.log.Info("add permissionless delegators to elastic subnets")
+	return c.controlc.AddPermissionlessDelegator(ctx, req)
+}
+
+func (c *client) AddPrimaryDelegator(ctx context.Context, delegatorSpec []*rpcpb.PrimaryDelegatorSpec) (*rpcpb.AddPrimaryDelegatorResponse, error) {
+	req := &rpcpb.AddPrimaryDelegatorRequest{
+		DelegatorSpec: delegatorSpec,
+		NetworkName:   c.cfg.NetworkName,
+	}
+
+	c.log.Info("add primary network delegators")
+	return c.controlc.AddPrimaryDelegator(ctx, req)
+}
+
 func (c *client) RemoveSubnetValidator(ctx context.Context, validatorSpec []*rpcpb.RemoveSubnetValidatorSpec) (*rpcpb.RemoveSubnetValidatorResponse, error) {
 	req := &rpcpb.RemoveSubnetValidatorRequest{
 		ValidatorSpec: validatorSpec,
+		NetworkName:   c.cfg.NetworkName,
 	}
 
 	c.log.Info("remove subnet validator")
@@ -190,17 +250,17 @@ func (c *client) RemoveSubnetValidator(ctx context.Context, validatorSpec []*rpc
 
 func (c *client) Health(ctx context.Context) (*rpcpb.HealthResponse, error) {
 	c.log.Info("health")
-	return c.controlc.Health(ctx, &rpcpb.HealthRequest{})
+	return c.controlc.Health(ctx, &rpcpb.HealthRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) WaitForHealthy(ctx context.Context) (*rpcpb.WaitForHealthyResponse, error) {
 	c.log.Info("wait for healthy")
-	return c.controlc.WaitForHealthy(ctx, &rpcpb.WaitForHealthyRequest{})
+	return c.controlc.WaitForHealthy(ctx, &rpcpb.WaitForHealthyRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) URIs(ctx context.Context) ([]string, error) {
 	c.log.Info("uris")
-	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{})
+	resp, err := c.controlc.URIs(ctx, &rpcpb.URIsRequest{NetworkName: c.cfg.NetworkName})
 	if err != nil {
 		return nil, err
 	}
@@ -209,12 +269,13 @@ func (c *client) URIs(ctx context.Context) ([]string, error) {
 
 func (c *client) Status(ctx context.Context) (*rpcpb.StatusResponse, error) {
 	c.log.Info("status")
-	return c.controlc.Status(ctx, &rpcpb.StatusRequest{})
+	return c.controlc.Status(ctx, &rpcpb.StatusRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (<-chan *rpcpb.ClusterInfo, error) {
 	stream, err := c.controlc.StreamStatus(ctx, &rpcpb.StreamStatusRequest{
 		PushInterval: int64(pushInterval),
+		NetworkName:  c.cfg.NetworkName,
 	})
 	if err != nil {
 		return nil, err
@@ -259,9 +320,235 @@ func (c *client) StreamStatus(ctx context.Context, pushInterval time.Duration) (
 	return ch, nil
 }
 
+// Returns a channel that receives the lifecycle events of the network.
+// The channel is closed when the stream ends, after which it can be resumed
+// with [WithAfterSequence] and the sequence number of the last received event.
+func (c *client) StreamEvents(ctx context.Context, opts ...OpOption) (<-chan *rpcpb.StreamEventsResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	stream, err := c.controlc.StreamEvents(ctx, &rpcpb.StreamEventsRequest{
+		NetworkName:   c.cfg.NetworkName,
+		AfterSequence: ret.afterSequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.StreamEventsResponse, 1)
+	go func() {
+		defer close(ch)
+		c.log.Info("start event receive routine")
+		for {
+			resp, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- resp:
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+				continue
+			}
+
+			if errors.Is(err, io.EOF) {
+				c.log.Debug("received EOF from server")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				c.log.Debug("event stream canceled", zap.Error(err))
+			} else {
+				c.log.Warn("failed to receive event from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
+func (c *client) GetNodeLogs(
+	ctx context.Context,
+	nodeNames []string,
+	filter *rpcpb.LogFilter,
+	offset uint32,
+	limit uint32,
+) (*rpcpb.GetNodeLogsResponse, error) {
+	c.log.Info("get node logs", zap.Strings("node-names", nodeNames))
+	return c.controlc.GetNodeLogs(ctx, &rpcpb.GetNodeLogsRequest{
+		NodeNames:   nodeNames,
+		Filter:      filter,
+		Offset:      offset,
+		Limit:       limit,
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
+func (c *client) TailLogs(ctx context.Context, nodeNames []string, filter *rpcpb.LogFilter, lines uint32) (<-chan *rpcpb.LogEntry, error) {
+	c.log.Info("tail logs", zap.Strings("node-names", nodeNames))
+	stream, err := c.controlc.TailLogs(ctx, &rpcpb.TailLogsRequest{
+		NodeNames:   nodeNames,
+		Filter:      filter,
+		Lines:       lines,
+		NetworkName: c.cfg.NetworkName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *rpcpb.LogEntry, 1)
+	go func() {
+		defer close(ch)
+		for {
+			resp, err := stream.Recv()
+			if err == nil {
+				select {
+				case ch <- resp.Entry:
+				case <-ctx.Done():
+					return
+				case <-c.closed:
+					return
+				}
+				continue
+			}
+
+			if errors.Is(err, io.EOF) {
+				c.log.Debug("received EOF from server")
+				return
+			}
+			if isClientCanceled(stream.Context().Err(), err) {
+				c.log.Debug("log stream canceled", zap.Error(err))
+			} else {
+				c.log.Warn("failed to receive log entry from gRPC stream", zap.Error(err))
+			}
+			return
+		}
+	}()
+	return ch, nil
+}
+
+// Writes the archive of the server snapshot [snapshotName] to [w].
+func (c *client) ExportSnapshot(ctx context.Context, snapshotName string, w io.Writer) error {
+	c.log.Info("export snapshot", zap.String("snapshot-name", snapshotName))
+	stream, err := c.controlc.ExportSnapshot(ctx, &rpcpb.ExportSnapshotRequest{SnapshotName: snapshotName})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(resp.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// Sends the snapshot archive read from [r] to the server, which registers
+// it as [snapshotName], or as its exported name if empty.
+func (c *client) ImportSnapshot(ctx context.Context, snapshotName string, r io.Reader) (*rpcpb.ImportSnapshotResponse, error) {
+	c.log.Info("import snapshot", zap.String("snapshot-name", snapshotName))
+	stream, err := c.controlc.ImportSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, snapshotArchiveChunkSize)
+	first := true
+	for {
+		n, err := io.ReadFull(r, buf)
+		if errors.Is(err, io.EOF) && !first {
+			break
+		}
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		req := &rpcpb.ImportSnapshotRequest{Chunk: append([]byte{}, buf[:n]...)}
+		if first {
+			req.SnapshotName = snapshotName
+			first = false
+		}
+		if sendErr := stream.Send(req); sendErr != nil {
+			// the server failure is returned by CloseAndRecv
+			if errors.Is(sendErr, io.EOF) {
+				break
+			}
+			return nil, sendErr
+		}
+		if err != nil {
+			break
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func (c *client) Prune(ctx context.Context, opts ...OpOption) (*rpcpb.PruneResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("prune", zap.Bool("dry-run", ret.dryRun))
+	return c.controlc.Prune(ctx, &rpcpb.PruneRequest{
+		RootDataDir: ret.rootDataDir,
+		DryRun:      ret.dryRun,
+	})
+}
+
+func (c *client) MigrateSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.MigrateSnapshotResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("migrate snapshot", zap.String("snapshot-name", snapshotName), zap.Bool("dry-run", ret.dryRun))
+	return c.controlc.MigrateSnapshot(ctx, &rpcpb.MigrateSnapshotRequest{
+		SnapshotName: snapshotName,
+		DryRun:       ret.dryRun,
+	})
+}
+
+func (c *client) Fund(ctx context.Context, address string, amount uint64, opts ...OpOption) (*rpcpb.FundResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("fund", zap.String("address", address), zap.Uint64("amount", amount), zap.String("asset-id", ret.assetID))
+	return c.controlc.Fund(ctx, &rpcpb.FundRequest{
+		NetworkName: c.cfg.NetworkName,
+		Address:     address,
+		AssetId:     ret.assetID,
+		Amount:      amount,
+	})
+}
+
+func (c *client) CrossChainTransfer(
+	ctx context.Context,
+	sourceChain string,
+	destinationChain string,
+	amount uint64,
+	opts ...OpOption,
+) (*rpcpb.CrossChainTransferResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("cross chain transfer",
+		zap.String("source-chain", sourceChain),
+		zap.String("destination-chain", destinationChain),
+		zap.Uint64("amount", amount),
+		zap.String("asset-id", ret.assetID),
+	)
+	return c.controlc.CrossChainTransfer(ctx, &rpcpb.CrossChainTransferRequest{
+		NetworkName:      c.cfg.NetworkName,
+		SourceChain:      sourceChain,
+		DestinationChain: destinationChain,
+		AssetId:          ret.assetID,
+		Amount:           amount,
+		PrivateKey:       ret.privateKey,
+	})
+}
+
 func (c *client) Stop(ctx context.Context) (*rpcpb.StopResponse, error) {
 	c.log.Info("stop")
-	return c.controlc.Stop(ctx, &rpcpb.StopRequest{})
+	return c.controlc.Stop(ctx, &rpcpb.StopRequest{NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) AddNode(ctx context.Context, name string, execPath string, opts ...OpOption) (*rpcpb.AddNodeResponse, error) {
@@ -275,6 +562,9 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 		ChainConfigs:   ret.chainConfigs,
 		UpgradeConfigs: ret.upgradeConfigs,
 		SubnetConfigs:  ret.subnetConfigs,
+		NetworkName:    c.cfg.NetworkName,
+		RestartPolicy:  ret.restartPolicy,
+		CloneDbFrom:    ret.cloneDBFrom,
 	}
 
 	if ret.pluginDir != "" {
@@ -287,24 +577,24 @@ func (c *client) AddNode(ctx context.Context, name string, execPath string, opts
 
 func (c *client) RemoveNode(ctx context.Context, name string) (*rpcpb.RemoveNodeResponse, error) {
 	c.log.Info("remove node", zap.String("name", name))
-	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name})
+	return c.controlc.RemoveNode(ctx, &rpcpb.RemoveNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) PauseNode(ctx context.Context, name string) (*rpcpb.PauseNodeResponse, error) {
 	c.log.Info("pause node", zap.String("name", name))
-	return c.controlc.PauseNode(ctx, &rpcpb.PauseNodeRequest{Name: name})
+	return c.controlc.PauseNode(ctx, &rpcpb.PauseNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) ResumeNode(ctx context.Context, name string) (*rpcpb.ResumeNodeResponse, error) {
 	c.log.Info("resume node", zap.String("name", name))
-	return c.controlc.ResumeNode(ctx, &rpcpb.ResumeNodeRequest{Name: name})
+	return c.controlc.ResumeNode(ctx, &rpcpb.ResumeNodeRequest{Name: name, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) RestartNode(ctx context.Context, name string, opts ...OpOption) (*rpcpb.RestartNodeResponse, error) {
 	ret := &Op{}
 	ret.applyOpts(opts)
 
-	req := &rpcpb.RestartNodeRequest{Name: name}
+	req := &rpcpb.RestartNodeRequest{Name: name, NetworkName: c.cfg.NetworkName}
 	if ret.execPath != "" {
 		req.ExecPath = &ret.execPath
 	}
@@ -324,22 +614,32 @@ func (c *client) RestartNode(ctx context.Context, name string, opts ...OpOption)
 
 func (c *client) AttachPeer(ctx context.Context, nodeName string) (*rpcpb.AttachPeerResponse, error) {
 	c.log.Info("attaching peer", zap.String("name", nodeName))
-	return c.controlc.AttachPeer(ctx, &rpcpb.AttachPeerRequest{NodeName: nodeName})
+	return c.controlc.AttachPeer(ctx, &rpcpb.AttachPeerRequest{NodeName: nodeName, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) SendOutboundMessage(ctx context.Context, nodeName string, peerID string, op uint32, msgBody []byte) (*rpcpb.SendOutboundMessageResponse, error) {
 	c.log.Info("sending outbound message", zap.String("name", nodeName), zap.String("peer-ID", peerID))
 	return c.controlc.SendOutboundMessage(ctx, &rpcpb.SendOutboundMessageRequest{
-		NodeName: nodeName,
-		PeerId:   peerID,
-		Op:       op,
-		Bytes:    msgBody,
+		NodeName:    nodeName,
+		PeerId:      peerID,
+		Op:          op,
+		Bytes:       msgBody,
+		NetworkName: c.cfg.NetworkName,
 	})
 }
 
-func (c *client) SaveSnapshot(ctx context.Context, snapshotName string) (*rpcpb.SaveSnapshotResponse, error) {
+func (c *client) SaveSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.SaveSnapshotResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
 	c.log.Info("save snapshot", zap.String("snapshot-name", snapshotName))
-	return c.controlc.SaveSnapshot(ctx, &rpcpb.SaveSnapshotRequest{SnapshotName: snapshotName})
+	return c.controlc.SaveSnapshot(ctx, &rpcpb.SaveSnapshotRequest{
+		SnapshotName: snapshotName,
+		NetworkName:  c.cfg.NetworkName,
+		KeepRunning:  ret.keepRunning,
+		Description:  ret.snapshotDescription,
+		Labels:       ret.snapshotLabels,
+	})
 }
 
 func (c *client) LoadSnapshot(ctx context.Context, snapshotName string, opts ...OpOption) (*rpcpb.LoadSnapshotResponse, error) {
@@ -347,10 +647,15 @@ func (c *client) LoadSnapshot(ctx context.Context, snapshotName string, opts ...
 	ret := &Op{}
 	ret.applyOpts(opts)
 	req := rpcpb.LoadSnapshotRequest{
-		SnapshotName:   snapshotName,
-		ChainConfigs:   ret.chainConfigs,
-		UpgradeConfigs: ret.upgradeConfigs,
-		SubnetConfigs:  ret.subnetConfigs,
+		SnapshotName:      snapshotName,
+		ChainConfigs:      ret.chainConfigs,
+		UpgradeConfigs:    ret.upgradeConfigs,
+		SubnetConfigs:     ret.subnetConfigs,
+		NetworkName:       c.cfg.NetworkName,
+		DropNodes:         ret.dropNodes,
+		PausedNodes:       ret.pausedNodes,
+		ExtraNodeConfigs:  ret.extraNodeConfigs,
+		AllowIncompatible: ret.allowIncompatible,
 	}
 	if ret.execPath != "" {
 		req.ExecPath = &ret.execPath
@@ -370,18 +675,115 @@ func (c *client) LoadSnapshot(ctx context.Context, snapshotName string, opts ...
 
 func (c *client) RemoveSnapshot(ctx context.Context, snapshotName string) (*rpcpb.RemoveSnapshotResponse, error) {
 	c.log.Info("remove snapshot", zap.String("snapshot-name", snapshotName))
-	return c.controlc.RemoveSnapshot(ctx, &rpcpb.RemoveSnapshotRequest{SnapshotName: snapshotName})
+	return c.controlc.RemoveSnapshot(ctx, &rpcpb.RemoveSnapshotRequest{SnapshotName: snapshotName, NetworkName: c.cfg.NetworkName})
 }
 
 func (c *client) GetSnapshotNames(ctx context.Context) ([]string, error) {
 	c.log.Info("get snapshot names")
-	resp, err := c.controlc.GetSnapshotNames(ctx, &rpcpb.GetSnapshotNamesRequest{})
+	resp, err := c.controlc.GetSnapshotNames(ctx, &rpcpb.GetSnapshotNamesRequest{NetworkName: c.cfg.NetworkName})
 	if err != nil {
 		return nil, err
 	}
 	return resp.SnapshotNames, nil
 }
 
+func (c *client) GetSnapshotInfo(ctx context.Context, snapshotName string) (*rpcpb.SnapshotInfo, error) {
+	c.log.Info("get snapshot info", zap.String("snapshot-name", snapshotName))
+	resp, err := c.controlc.GetSnapshotInfo(ctx, &rpcpb.GetSnapshotInfoRequest{SnapshotName: snapshotName})
+	if err != nil {
+		return nil, err
+	}
+	return resp.SnapshotInfo, nil
+}
+
+// Returns the snapshots that have all the [labels].
+func (c *client) ListSnapshots(ctx context.Context, labels map[string]string) ([]*rpcpb.SnapshotInfo, error) {
+	c.log.Info("list snapshots")
+	resp, err := c.controlc.ListSnapshots(ctx, &rpcpb.ListSnapshotsRequest{Labels: labels})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Snapshots, nil
+}
+
+func (c *client) ListNetworks(ctx context.Context) (*rpcpb.ListNetworksResponse, error) {
+	c.log.Info("list networks")
+	return c.controlc.ListNetworks(ctx, &rpcpb.ListNetworksRequest{})
+}
+
+func (c *client) ApplyManifest(ctx context.Context, manifest string, opts ...OpOption) (*rpcpb.ApplyManifestResponse, error) {
+	ret := &Op{}
+	ret.applyOpts(opts)
+
+	c.log.Info("apply manifest", zap.Bool("dry-run", ret.dryRun))
+	return c.controlc.ApplyManifest(ctx, &rpcpb.ApplyManifestRequest{
+		Manifest:    manifest,
+		DryRun:      ret.dryRun,
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
+func (c *client) PartitionNodes(ctx context.Context, groups [][]string) (*rpcpb.PartitionNodesResponse, error) {
+	req := &rpcpb.PartitionNodesRequest{NetworkName: c.cfg.NetworkName}
+	for _, group := range groups {
+		req.Groups = append(req.Groups, &rpcpb.NodeGroup{NodeNames: group})
+	}
+	c.log.Info("partition nodes", zap.Any("groups", groups))
+	return c.controlc.PartitionNodes(ctx, req)
+}
+
+func (c *client) HealPartitions(ctx context.Context) (*rpcpb.HealPartitionsResponse, error) {
+	c.log.Info("heal partitions")
+	return c.controlc.HealPartitions(ctx, &rpcpb.HealPartitionsRequest{NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) SetLinkLatency(
+	ctx context.Context,
+	from string,
+	to string,
+	delay time.Duration,
+	jitter time.Duration,
+) (*rpcpb.SetLinkLatencyResponse, error) {
+	c.log.Info("set link latency",
+		zap.String("from", from),
+		zap.String("to", to),
+		zap.Duration("delay", delay),
+		zap.Duration("jitter", jitter),
+	)
+	return c.controlc.SetLinkLatency(ctx, &rpcpb.SetLinkLatencyRequest{
+		From:        from,
+		To:          to,
+		DelayMs:     uint64(delay.Milliseconds()),
+		JitterMs:    uint64(jitter.Milliseconds()),
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
+func (c *client) SetLinkLoss(ctx context.Context, from string, to string, loss float64) (*rpcpb.SetLinkLossResponse, error) {
+	c.log.Info("set link loss", zap.String("from", from), zap.String("to", to), zap.Float64("loss", loss))
+	return c.controlc.SetLinkLoss(ctx, &rpcpb.SetLinkLossRequest{
+		From:        from,
+		To:          to,
+		Loss:        loss,
+		NetworkName: c.cfg.NetworkName,
+	})
+}
+
+func (c *client) StartChaos(ctx context.Context, policy *rpcpb.ChaosPolicy) (*rpcpb.StartChaosResponse, error) {
+	c.log.Info("start chaos", zap.Int64("seed", policy.GetSeed()))
+	return c.controlc.StartChaos(ctx, &rpcpb.StartChaosRequest{Policy: policy, NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) StopChaos(ctx context.Context) (*rpcpb.StopChaosResponse, error) {
+	c.log.Info("stop chaos")
+	return c.controlc.StopChaos(ctx, &rpcpb.StopChaosRequest{NetworkName: c.cfg.NetworkName})
+}
+
+func (c *client) GetChaosTimeline(ctx context.Context) (*rpcpb.GetChaosTimelineResponse, error) {
+	c.log.Info("get chaos timeline")
+	return c.controlc.GetChaosTimeline(ctx, &rpcpb.GetChaosTimelineRequest{NetworkName: c.cfg.NetworkName})
+}
+
 func (c *client) Close() error {
 	c.closeOnce.Do(func() {
 		close(c.closed)
@@ -404,6 +806,24 @@ type Op struct {
 	subnetConfigs       map[string]string
 	reassignPortsIfUsed bool
 	dynamicPorts        bool
+	dryRun              bool
+	faultInjection      bool
+	restartPolicy       *rpcpb.RestartPolicy
+	genesisSpec         *rpcpb.GenesisSpec
+	keySeed             *int64
+	numTestAccounts     uint32
+	testAccountBalance  *uint64
+	assetID             string
+	privateKey          string
+	afterSequence       *uint64
+	keepRunning         bool
+	snapshotDescription string
+	snapshotLabels      map[string]string
+	dropNodes           []string
+	pausedNodes         []string
+	extraNodeConfigs    map[string]string
+	cloneDBFrom         string
+	allowIncompatible   bool
 }
 
 type OpOption func(*Op)
@@ -509,6 +929,132 @@ func WithDynamicPorts(dynamicPorts bool) OpOption {
 	}
 }
 
+// Route the p2p traffic through a proxy that can inject partitions,
+// latency and packet loss.
+func WithFaultInjection(faultInjection bool) OpOption {
+	return func(op *Op) {
+		op.faultInjection = faultInjection
+	}
+}
+
+// Start the network with a genesis generated from [genesisSpec], instead of the local genesis.
+func WithGenesisSpec(genesisSpec *rpcpb.GenesisSpec) OpOption {
+	return func(op *Op) {
+		op.genesisSpec = genesisSpec
+	}
+}
+
+// Derive the staking keys of the nodes from [keySeed], so the same seed always gives the same node IDs.
+func WithKeySeed(keySeed int64) OpOption {
+	return func(op *Op) {
+		op.keySeed = &keySeed
+	}
+}
+
+// Create [numTestAccounts] funded test accounts, returned in the cluster info.
+func WithNumTestAccounts(numTestAccounts uint32) OpOption {
+	return func(op *Op) {
+		op.numTestAccounts = numTestAccounts
+	}
+}
+
+// Fund each test account with [testAccountBalance] nDIONE on each chain.
+func WithTestAccountBalance(testAccountBalance uint64) OpOption {
+	return func(op *Op) {
+		op.testAccountBalance = &testAccountBalance
+	}
+}
+
+// Relaunch the node processes that exit unexpectedly, as given by [restartPolicy].
+func WithRestartPolicy(restartPolicy *rpcpb.RestartPolicy) OpOption {
+	return func(op *Op) {
+		op.restartPolicy = restartPolicy
+	}
+}
+
+// Resend the events kept by the server with a sequence number greater than
+// [afterSequence], before the new ones.
+func WithAfterSequence(afterSequence uint64) OpOption {
+	return func(op *Op) {
+		op.afterSequence = &afterSequence
+	}
+}
+
+// Send the A-chain asset [assetID] instead of DIONE.
+func WithAssetID(assetID string) OpOption {
+	return func(op *Op) {
+		op.assetID = assetID
+	}
+}
+
+// Sign with the "PrivateKey-" prefixed [privateKey] instead of the EWOQ key.
+func WithPrivateKey(privateKey string) OpOption {
+	return func(op *Op) {
+		op.privateKey = privateKey
+	}
+}
+
+// Only compute the changes, without applying them.
+func WithDryRun(dryRun bool) OpOption {
+	return func(op *Op) {
+		op.dryRun = dryRun
+	}
+}
+
+// Restart the nodes after saving a snapshot, instead of stopping the network.
+func WithKeepRunning(keepRunning bool) OpOption {
+	return func(op *Op) {
+		op.keepRunning = keepRunning
+	}
+}
+
+func WithSnapshotDescription(description string) OpOption {
+	return func(op *Op) {
+		op.snapshotDescription = description
+	}
+}
+
+func WithSnapshotLabels(labels map[string]string) OpOption {
+	return func(op *Op) {
+		op.snapshotLabels = labels
+	}
+}
+
+// Don't load the snapshot nodes [nodeNames].
+func WithDropNodes(nodeNames []string) OpOption {
+	return func(op *Op) {
+		op.dropNodes = nodeNames
+	}
+}
+
+// Leave the snapshot nodes [nodeNames] paused once loaded.
+func WithPausedNodes(nodeNames []string) OpOption {
+	return func(op *Op) {
+		op.pausedNodes = nodeNames
+	}
+}
+
+// Add fresh nodes to the snapshot ones, as node name to node config.
+func WithExtraNodeConfigs(extraNodeConfigs map[string]string) OpOption {
+	return func(op *Op) {
+		op.extraNodeConfigs = extraNodeConfigs
+	}
+}
+
+// Seed the DB of the added node with a copy of the DB of [nodeName].
+func WithCloneDBFrom(nodeName string) OpOption {
+	return func(op *Op) {
+		op.cloneDBFrom = nodeName
+	}
+}
+
+// Load the snapshot even if the node binaries or VM plugins are incompatible with it.
+func WithAllowIncompatible(allowIncompatible bool) OpOption {
+	return func(op *Op) {
+		op.allowIncompatible = allowIncompatible
+	}
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
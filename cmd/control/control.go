@@ -11,7 +11,11 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/DioneProtocol/odyssey-network-runner/client"
@@ -38,6 +42,7 @@ var (
 	endpoint       string
 	dialTimeout    time.Duration
 	requestTimeout time.Duration
+	networkName    string
 	log            logging.Logger
 )
 
@@ -54,6 +59,7 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&endpoint, "endpoint", "0.0.0.0:8080", "server endpoint")
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().DurationVar(&requestTimeout, "request-timeout", 3*time.Minute, "client request timeout")
+	cmd.PersistentFlags().StringVar(&networkName, "network", "", "name of the server network to operate on (server default if empty)")
 
 	cmd.AddCommand(
 		newRPCVersionCommand(),
@@ -62,12 +68,17 @@ func NewCommand() *cobra.Command {
 		newCreateSubnetsCommand(),
 		newTransformElasticSubnetsCommand(),
 		newAddPermissionlessValidatorCommand(),
+		newAddPermissionlessDelegatorCommand(),
+		newAddPrimaryDelegatorCommand(),
 		newRemoveSubnetValidatorCommand(),
 		newHealthCommand(),
 		newWaitForHealthyCommand(),
 		newURIsCommand(),
 		newStatusCommand(),
+		newTopCommand(),
 		newStreamStatusCommand(),
+		newStreamEventsCommand(),
+		newLogsCommand(),
 		newAddNodeCommand(),
 		newRemoveNodeCommand(),
 		newPauseNodeCommand(),
@@ -80,6 +91,21 @@ func NewCommand() *cobra.Command {
 		newLoadSnapshotCommand(),
 		newRemoveSnapshotCommand(),
 		newGetSnapshotNamesCommand(),
+		newInspectSnapshotCommand(),
+		newExportSnapshotCommand(),
+		newImportSnapshotCommand(),
+		newPruneCommand(),
+		newMigrateSnapshotCommand(),
+		newFundCommand(),
+		newCrossChainTransferCommand(),
+		newGenKeysCommand(),
+		newListNetworksCommand(),
+		newApplyCommand(),
+		newPartitionNodesCommand(),
+		newHealPartitionsCommand(),
+		newSetLinkLatencyCommand(),
+		newSetLinkLossCommand(),
+		newChaosCommand(),
 	)
 
 	return cmd
@@ -99,6 +125,39 @@ var (
 	subnetConfigs       string
 	reassignPortsIfUsed bool
 	dynamicPorts        bool
+	faultInjection      bool
+	restartPolicyType   string
+	maxRestarts         uint32
+	restartBackoff      time.Duration
+	maxRestartBackoff   time.Duration
+	afterSequence       int64
+	logsChain           string
+	logsLevel           string
+	logsSince           time.Duration
+	logsContains        string
+	logsFollow          bool
+	logsLines           uint32
+	logsOffset          uint32
+	logsLimit           uint32
+	dropNodes           []string
+	pausedNodes         []string
+	extraNodeConfigs    string
+	cloneDBFrom         string
+	allowIncompatible   bool
+	genesisNetworkID    uint32
+	aChainBalances      map[string]string
+	oChainBalances      map[string]string
+	dChainBalances      map[string]string
+	initialStakers      []string
+	delegationFee       uint32
+	stakeDuration       time.Duration
+	stakeDurationOffset time.Duration
+	keySeed             int64
+	numTestAccounts     uint32
+	testAccountBalance  uint64
+	fundAssetID         string
+	transferAssetID     string
+	transferPrivateKey  string
 )
 
 func setLogs() error {
@@ -243,13 +302,39 @@ func newStartCommand() *cobra.Command {
 		false,
 		"true to assign dynamic ports",
 	)
+	cmd.PersistentFlags().BoolVar(
+		&faultInjection,
+		"fault-injection",
+		false,
+		"true to route p2p traffic through a proxy that can inject partitions, latency and packet loss",
+	)
+	addRestartPolicyFlags(cmd)
+	addGenesisFlags(cmd)
+	cmd.PersistentFlags().Int64Var(
+		&keySeed,
+		"key-seed",
+		0,
+		"[optional] seed the staking keys of the nodes are derived from, so the node IDs are the same on every start",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&numTestAccounts,
+		"num-test-accounts",
+		0,
+		"[optional] number of test accounts to create and fund on the A-, O- and D-chains, with keys derived from --key-seed",
+	)
+	cmd.PersistentFlags().Uint64Var(
+		&testAccountBalance,
+		"test-account-balance",
+		0,
+		"[optional] nDIONE given to each test account on each chain (default 1000 DIONE)",
+	)
 	if err := cmd.MarkPersistentFlagRequired("odysseygo-path"); err != nil {
 		panic(err)
 	}
 	return cmd
 }
 
-func startFunc(*cobra.Command, []string) error {
+func startFunc(cmd *cobra.Command, _ []string) error {
 	cli, err := newClient()
 	if err != nil {
 		return err
@@ -263,6 +348,21 @@ func startFunc(*cobra.Command, []string) error {
 		client.WithRootDataDir(rootDataDir),
 		client.WithReassignPortsIfUsed(reassignPortsIfUsed),
 		client.WithDynamicPorts(dynamicPorts),
+		client.WithFaultInjection(faultInjection),
+		client.WithRestartPolicy(getRestartPolicy()),
+	}
+
+	if genesisSpec := getGenesisSpec(cmd); genesisSpec != nil {
+		opts = append(opts, client.WithGenesisSpec(genesisSpec))
+	}
+	if cmd.Flags().Changed("key-seed") {
+		opts = append(opts, client.WithKeySeed(keySeed))
+	}
+	if numTestAccounts > 0 {
+		opts = append(opts, client.WithNumTestAccounts(numTestAccounts))
+	}
+	if cmd.Flags().Changed("test-account-balance") {
+		opts = append(opts, client.WithTestAccountBalance(testAccountBalance))
 	}
 
 	if globalNodeConfig != "" {
@@ -424,6 +524,26 @@ func newAddPermissionlessValidatorCommand() *cobra.Command {
 	return cmd
 }
 
+func newAddPermissionlessDelegatorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-permissionless-delegator permissionlessDelegatorSpecs [options]",
+		Short: "Add permissionless delegator to validators of elastic subnets.",
+		RunE:  addPermissionlessDelegatorFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	return cmd
+}
+
+func newAddPrimaryDelegatorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-primary-delegator primaryDelegatorSpecs [options]",
+		Short: "Add delegator to primary network validators.",
+		RunE:  addPrimaryDelegatorFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	return cmd
+}
+
 func newRemoveSubnetValidatorCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove-subnet-validator removeValidatorSpec [options]",
@@ -490,6 +610,62 @@ func addPermissionlessValidatorFunc(_ *cobra.Command, args []string) error {
 	return nil
 }
 
+func addPermissionlessDelegatorFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	delegatorSpecStr := args[0]
+
+	delegatorSpec := []*rpcpb.PermissionlessDelegatorSpec{}
+	if err := json.Unmarshal([]byte(delegatorSpecStr), &delegatorSpec); err != nil {
+		return err
+	}
+
+	ctx := getAsyncContext()
+
+	info, err := cli.AddPermissionlessDelegator(
+		ctx,
+		delegatorSpec,
+	)
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("add-permissionless-delegator response: %+v"), info)
+	return nil
+}
+
+func addPrimaryDelegatorFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	delegatorSpecStr := args[0]
+
+	delegatorSpec := []*rpcpb.PrimaryDelegatorSpec{}
+	if err := json.Unmarshal([]byte(delegatorSpecStr), &delegatorSpec); err != nil {
+		return err
+	}
+
+	ctx := getAsyncContext()
+
+	info, err := cli.AddPrimaryDelegator(
+		ctx,
+		delegatorSpec,
+	)
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("add-primary-delegator response: %+v"), info)
+	return nil
+}
+
 func removeSubnetValidatorFunc(_ *cobra.Command, args []string) error {
 	cli, err := newClient()
 	if err != nil {
@@ -630,6 +806,118 @@ func statusFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+var (
+	topInterval time.Duration
+	topOnce     bool
+)
+
+func newTopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top [options]",
+		Short: "Shows the resource usage of the node processes, refreshed until interrupted.",
+		RunE:  topFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.PersistentFlags().DurationVar(
+		&topInterval,
+		"interval",
+		10*time.Second,
+		"[optional] interval between refreshes",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&topOnce,
+		"once",
+		false,
+		"[optional] print the resource usage once and exit",
+	)
+	return cmd
+}
+
+func topFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := cli.Status(ctx)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if !topOnce {
+			// clear the terminal
+			fmt.Print("\033[H\033[2J")
+		}
+		ux.Print(log, "%s", formatResourceUsage(resp.ClusterInfo))
+		if topOnce {
+			return nil
+		}
+		select {
+		case sig := <-sigc:
+			log.Warn("received signal", zap.String("signal", sig.String()))
+			return nil
+		case <-time.After(topInterval):
+		}
+	}
+}
+
+// Returns a table of the resource usage of the nodes. The memory trend is
+// the RSS change over the kept samples.
+func formatResourceUsage(clusterInfo *rpcpb.ClusterInfo) string {
+	sb := &strings.Builder{}
+	w := tabwriter.NewWriter(sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tPROCS\tCPU%\tRSS\tRSS TREND\tFDS\tTHREADS\tREAD\tWRITE\tRESTARTS")
+	for _, nodeName := range clusterInfo.GetNodeNames() {
+		nodeInfo := clusterInfo.NodeInfos[nodeName]
+		usage := nodeInfo.GetResourceUsage()
+		if nodeInfo.GetPaused() || usage == nil {
+			fmt.Fprintf(w, "%s\t-\t-\t-\t-\t-\t-\t-\t-\t%d\n", nodeName, nodeInfo.GetRestartCount())
+			continue
+		}
+		history := nodeInfo.GetResourceHistory()
+		trend := int64(usage.RssBytes) - int64(history[0].RssBytes)
+		trendSign := "+"
+		if trend < 0 {
+			trendSign, trend = "-", -trend
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%s\t%s%s/%s\t%d\t%d\t%s\t%s\t%d\n",
+			nodeName,
+			usage.NumProcesses,
+			usage.CpuPercent,
+			formatBytes(usage.RssBytes),
+			trendSign,
+			formatBytes(uint64(trend)),
+			time.UnixMilli(usage.Timestamp).Sub(time.UnixMilli(history[0].Timestamp)).Round(time.Second),
+			usage.NumFds,
+			usage.NumThreads,
+			formatBytes(usage.ReadBytes),
+			formatBytes(usage.WriteBytes),
+			nodeInfo.GetRestartCount(),
+		)
+	}
+	_ = w.Flush()
+	return sb.String()
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 var pushInterval time.Duration
 
 func newStreamStatusCommand() *cobra.Command {
@@ -683,6 +971,205 @@ func streamStatusFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+func newStreamEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events [options]",
+		Short: "Streams the lifecycle events of the network until interrupted.",
+		RunE:  streamEventsFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.PersistentFlags().Int64Var(
+		&afterSequence,
+		"after-sequence",
+		-1,
+		"[optional] first print the events kept by the server after this sequence number",
+	)
+	return cmd
+}
+
+func streamEventsFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case sig := <-sigc:
+			log.Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	opts := []client.OpOption{}
+	if afterSequence >= 0 {
+		opts = append(opts, client.WithAfterSequence(uint64(afterSequence)))
+	}
+	ch, err := cli.StreamEvents(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	for resp := range ch {
+		if resp.HistoryTruncated {
+			ux.Print(log, logging.Yellow.Wrap("some events after the requested sequence number are no longer kept"))
+		}
+		event := resp.Event
+		ux.Print(log, logging.Cyan.Wrap("%d %s %s %s"),
+			event.Sequence,
+			time.UnixMilli(event.Timestamp).Format(time.RFC3339),
+			strings.TrimPrefix(event.Type.String(), "EVENT_TYPE_"),
+			formatEventDetails(event),
+		)
+	}
+	return nil
+}
+
+// Returns the fields set on [event], besides its sequence, time and type.
+func formatEventDetails(event *rpcpb.Event) string {
+	details := []string{}
+	if event.NodeName != "" {
+		details = append(details, "node="+event.NodeName)
+	}
+	if event.Type == rpcpb.EventType_EVENT_TYPE_NODE_CRASHED {
+		details = append(details, fmt.Sprintf("exit-code=%d", event.ExitCode))
+	}
+	if event.SubnetId != "" {
+		details = append(details, "subnet="+event.SubnetId)
+	}
+	if event.BlockchainId != "" {
+		details = append(details, "blockchain="+event.BlockchainId)
+	}
+	if event.SnapshotName != "" {
+		details = append(details, "snapshot="+event.SnapshotName)
+	}
+	return strings.Join(details, " ")
+}
+
+func newLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [node-name] [options]",
+		Short: "Prints the logs of a node, or of all the nodes if no node is given.",
+		RunE:  logsFunc,
+		Args:  cobra.MaximumNArgs(1),
+	}
+	cmd.PersistentFlags().StringVar(
+		&logsChain,
+		"chain",
+		"",
+		"[optional] chain alias (e.g. D), blockchain ID or custom chain name whose log is printed, instead of the main log",
+	)
+	cmd.PersistentFlags().StringVar(
+		&logsLevel,
+		"level",
+		"",
+		"[optional] minimum level of the printed entries (e.g. WARN)",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&logsSince,
+		"since",
+		0,
+		"[optional] only print the entries of the given last duration (e.g. 10m)",
+	)
+	cmd.PersistentFlags().StringVar(
+		&logsContains,
+		"contains",
+		"",
+		"[optional] only print the entries containing the given text",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&logsFollow,
+		"follow",
+		false,
+		"[optional] print the new entries as they are written until interrupted",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&logsLines,
+		"lines",
+		10,
+		"[optional] number of past entries printed with --follow",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&logsOffset,
+		"offset",
+		0,
+		"[optional] index of the first printed entry",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&logsLimit,
+		"limit",
+		0,
+		"[optional] max number of printed entries (server default if 0)",
+	)
+	return cmd
+}
+
+func logsFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	filter := &rpcpb.LogFilter{
+		Chain:    logsChain,
+		MinLevel: logsLevel,
+		Contains: logsContains,
+	}
+	if logsSince > 0 {
+		filter.Since = time.Now().Add(-logsSince).UnixMilli()
+	}
+
+	if !logsFollow {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		resp, err := cli.GetNodeLogs(ctx, args, filter, logsOffset, logsLimit)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for _, entry := range resp.Entries {
+			printLogEntry(entry)
+		}
+		if resp.NextOffset != 0 {
+			ux.Print(log, logging.Yellow.Wrap("more entries available with --offset %d"), resp.NextOffset)
+		}
+		return nil
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case sig := <-sigc:
+			log.Warn("received signal", zap.String("signal", sig.String()))
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	ch, err := cli.TailLogs(ctx, args, filter, logsLines)
+	if err != nil {
+		return err
+	}
+	for entry := range ch {
+		printLogEntry(entry)
+	}
+	return nil
+}
+
+func printLogEntry(entry *rpcpb.LogEntry) {
+	ux.Print(log, "%s %s", logging.Cyan.Wrap("["+entry.NodeName+"]"), entry.Text)
+}
+
 func newRemoveNodeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove-node node-name [options]",
@@ -773,41 +1260,160 @@ func resumeNodeFunc(_ *cobra.Command, args []string) error {
 	return nil
 }
 
-func newAddNodeCommand() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "add-node node-name [options]",
-		Short: "Add a new node to the network",
-		RunE:  addNodeFunc,
-		Args:  cobra.ExactArgs(1),
-	}
+func addRestartPolicyFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVar(
-		&odysseyGoBinPath,
-		"odysseygo-path",
-		"",
-		"odysseygo binary path",
+		&restartPolicyType,
+		"restart-policy",
+		"never",
+		"relaunch policy of node processes that exit unexpectedly: never, on-failure or always",
 	)
-	cmd.PersistentFlags().StringVar(
-		&addNodeConfig,
-		"node-config",
-		"",
-		"node config as string",
+	cmd.PersistentFlags().Uint32Var(
+		&maxRestarts,
+		"max-restarts",
+		0,
+		"[optional] max number of relaunches of a node process (0 for no limit)",
 	)
-	cmd.PersistentFlags().StringVar(
-		&pluginDir,
-		"plugin-dir",
-		"",
-		"[optional] plugin directory",
+	cmd.PersistentFlags().DurationVar(
+		&restartBackoff,
+		"restart-backoff",
+		time.Second,
+		"wait before the first relaunch of a node process, doubled on each following one",
 	)
-	cmd.PersistentFlags().StringVar(
-		&chainConfigs,
-		"chain-configs",
-		"",
-		"[optional] JSON string of map from chain id to its config file contents",
+	cmd.PersistentFlags().DurationVar(
+		&maxRestartBackoff,
+		"max-restart-backoff",
+		time.Minute,
+		"max wait before a relaunch of a node process",
 	)
-	cmd.PersistentFlags().StringVar(
-		&upgradeConfigs,
-		"upgrade-configs",
-		"",
+}
+
+func getRestartPolicy() *rpcpb.RestartPolicy {
+	return &rpcpb.RestartPolicy{
+		Type:         restartPolicyType,
+		MaxRetries:   maxRestarts,
+		BackoffMs:    uint64(restartBackoff.Milliseconds()),
+		MaxBackoffMs: uint64(maxRestartBackoff.Milliseconds()),
+	}
+}
+
+var genesisFlags = []string{
+	"network-id",
+	"a-chain-balances",
+	"o-chain-balances",
+	"d-chain-balances",
+	"initial-stakers",
+	"delegation-fee",
+	"initial-stake-duration",
+	"initial-stake-duration-offset",
+}
+
+func addGenesisFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Uint32Var(
+		&genesisNetworkID,
+		"network-id",
+		0,
+		"[optional] network ID of a generated genesis, used instead of the local genesis if any genesis flag is given",
+	)
+	cmd.PersistentFlags().StringToStringVar(
+		&aChainBalances,
+		"a-chain-balances",
+		nil,
+		"[optional] A-chain balances of the generated genesis, in nDIONE (e.g. A-custom1...=1000000000)",
+	)
+	cmd.PersistentFlags().StringToStringVar(
+		&oChainBalances,
+		"o-chain-balances",
+		nil,
+		"[optional] O-chain balances of the generated genesis, in nDIONE (e.g. A-custom1...=1000000000)",
+	)
+	cmd.PersistentFlags().StringToStringVar(
+		&dChainBalances,
+		"d-chain-balances",
+		nil,
+		"[optional] D-chain balances of the generated genesis, in wei (e.g. 0x8db9...=1000000000000000000)",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&initialStakers,
+		"initial-stakers",
+		nil,
+		"[optional] nodes that validate from the generated genesis (comma-separated, default is all the nodes)",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&delegationFee,
+		"delegation-fee",
+		10_000,
+		"delegation fee of the initial stakers of the generated genesis, in parts per million",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&stakeDuration,
+		"initial-stake-duration",
+		0,
+		"[optional] stake duration of the initial stakers of the generated genesis (default is 1 year)",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&stakeDurationOffset,
+		"initial-stake-duration-offset",
+		0,
+		"[optional] offset between the stake ends of the initial stakers of the generated genesis (default is 90 minutes)",
+	)
+}
+
+// Returns nil if no genesis flag is given.
+func getGenesisSpec(cmd *cobra.Command) *rpcpb.GenesisSpec {
+	changed := false
+	for _, flag := range genesisFlags {
+		changed = changed || cmd.Flags().Changed(flag)
+	}
+	if !changed {
+		return nil
+	}
+	return &rpcpb.GenesisSpec{
+		NetworkId:                  genesisNetworkID,
+		AChainBalances:             aChainBalances,
+		OChainBalances:             oChainBalances,
+		DChainBalances:             dChainBalances,
+		InitialStakers:             initialStakers,
+		DelegationFee:              &delegationFee,
+		InitialStakeDuration:       uint64(stakeDuration.Seconds()),
+		InitialStakeDurationOffset: uint64(stakeDurationOffset.Seconds()),
+	}
+}
+
+func newAddNodeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-node node-name [options]",
+		Short: "Add a new node to the network",
+		RunE:  addNodeFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().StringVar(
+		&odysseyGoBinPath,
+		"odysseygo-path",
+		"",
+		"odysseygo binary path",
+	)
+	cmd.PersistentFlags().StringVar(
+		&addNodeConfig,
+		"node-config",
+		"",
+		"node config as string",
+	)
+	cmd.PersistentFlags().StringVar(
+		&pluginDir,
+		"plugin-dir",
+		"",
+		"[optional] plugin directory",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chainConfigs,
+		"chain-configs",
+		"",
+		"[optional] JSON string of map from chain id to its config file contents",
+	)
+	cmd.PersistentFlags().StringVar(
+		&upgradeConfigs,
+		"upgrade-configs",
+		"",
 		"[optional] JSON string of map from chain id to its upgrade file contents",
 	)
 	cmd.PersistentFlags().StringVar(
@@ -816,6 +1422,13 @@ func newAddNodeCommand() *cobra.Command {
 		"",
 		"[optional] JSON string of map from subnet id to its config file contents",
 	)
+	cmd.PersistentFlags().StringVar(
+		&cloneDBFrom,
+		"clone-db-from",
+		"",
+		"[optional] name of the node whose DB is copied into the new node, instead of bootstrapping from scratch",
+	)
+	addRestartPolicyFlags(cmd)
 	return cmd
 }
 
@@ -830,6 +1443,8 @@ func addNodeFunc(_ *cobra.Command, args []string) error {
 
 	opts := []client.OpOption{
 		client.WithPluginDir(pluginDir),
+		client.WithRestartPolicy(getRestartPolicy()),
+		client.WithCloneDBFrom(cloneDBFrom),
 	}
 
 	if addNodeConfig != "" {
@@ -1105,13 +1720,37 @@ func stopFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+var (
+	saveSnapshotKeepRunning bool
+	saveSnapshotDescription string
+	saveSnapshotLabels      map[string]string
+)
+
 func newSaveSnapshotCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "save-snapshot snapshot-name",
+		Use:   "save-snapshot snapshot-name [options]",
 		Short: "Requests server to save network snapshot.",
 		RunE:  saveSnapshotFunc,
 		Args:  cobra.ExactArgs(1),
 	}
+	cmd.PersistentFlags().BoolVar(
+		&saveSnapshotKeepRunning,
+		"keep-running",
+		false,
+		"[optional] restart the nodes after saving, instead of stopping the network",
+	)
+	cmd.PersistentFlags().StringVar(
+		&saveSnapshotDescription,
+		"description",
+		"",
+		"[optional] description of the snapshot",
+	)
+	cmd.PersistentFlags().StringToStringVar(
+		&saveSnapshotLabels,
+		"label",
+		nil,
+		"[optional] labels of the snapshot, as key=value (e.g. --label fixture=elastic)",
+	)
 	return cmd
 }
 
@@ -1123,7 +1762,13 @@ func saveSnapshotFunc(_ *cobra.Command, args []string) error {
 	defer cli.Close()
 
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	resp, err := cli.SaveSnapshot(ctx, args[0])
+	resp, err := cli.SaveSnapshot(
+		ctx,
+		args[0],
+		client.WithKeepRunning(saveSnapshotKeepRunning),
+		client.WithSnapshotDescription(saveSnapshotDescription),
+		client.WithSnapshotLabels(saveSnapshotLabels),
+	)
 	cancel()
 	if err != nil {
 		return err
@@ -1188,6 +1833,30 @@ func newLoadSnapshotCommand() *cobra.Command {
 		false,
 		"true to reassign snapshot ports if already taken",
 	)
+	cmd.PersistentFlags().StringSliceVar(
+		&dropNodes,
+		"drop-nodes",
+		nil,
+		"[optional] comma separated names of the snapshot nodes not to load",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&pausedNodes,
+		"paused-nodes",
+		nil,
+		"[optional] comma separated names of the snapshot nodes to leave paused once the network is healthy",
+	)
+	cmd.PersistentFlags().StringVar(
+		&extraNodeConfigs,
+		"extra-node-configs",
+		"",
+		"[optional] JSON string of map from name to node config of fresh nodes to add, which bootstrap from the snapshot nodes",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&allowIncompatible,
+		"allow-incompatible",
+		false,
+		"[optional] load even if the odysseygo binary or VM plugins are incompatible with the snapshot, only warning about it",
+	)
 	return cmd
 }
 
@@ -1203,6 +1872,9 @@ func loadSnapshotFunc(_ *cobra.Command, args []string) error {
 		client.WithPluginDir(pluginDir),
 		client.WithRootDataDir(rootDataDir),
 		client.WithReassignPortsIfUsed(reassignPortsIfUsed),
+		client.WithDropNodes(dropNodes),
+		client.WithPausedNodes(pausedNodes),
+		client.WithAllowIncompatible(allowIncompatible),
 	}
 
 	if chainConfigs != "" {
@@ -1226,6 +1898,13 @@ func loadSnapshotFunc(_ *cobra.Command, args []string) error {
 		}
 		opts = append(opts, client.WithSubnetConfigs(subnetConfigsMap))
 	}
+	if extraNodeConfigs != "" {
+		extraNodeConfigsMap := make(map[string]string)
+		if err := json.Unmarshal([]byte(extraNodeConfigs), &extraNodeConfigsMap); err != nil {
+			return err
+		}
+		opts = append(opts, client.WithExtraNodeConfigs(extraNodeConfigsMap))
+	}
 
 	if globalNodeConfig != "" {
 		ux.Print(log, logging.Yellow.Wrap("global node config provided, will be applied to all nodes: %s"), globalNodeConfig)
@@ -1304,6 +1983,852 @@ func getSnapshotNamesFunc(*cobra.Command, []string) error {
 	return nil
 }
 
+var inspectSnapshotLabels map[string]string
+
+func newInspectSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect-snapshot [snapshot-name] [options]",
+		Short: "Requests server to describe a snapshot, or to list all of them if no name is given.",
+		RunE:  inspectSnapshotFunc,
+		Args:  cobra.RangeArgs(0, 1),
+	}
+	cmd.PersistentFlags().StringToStringVar(
+		&inspectSnapshotLabels,
+		"label",
+		nil,
+		"[optional] only list the snapshots with these labels, as key=value",
+	)
+	return cmd
+}
+
+func inspectSnapshotFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if len(args) == 1 {
+		info, err := cli.GetSnapshotInfo(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		ux.Print(log, "%s", formatSnapshotInfo(info))
+		return nil
+	}
+	snapshots, err := cli.ListSnapshots(ctx, inspectSnapshotLabels)
+	if err != nil {
+		return err
+	}
+	ux.Print(log, "%s", formatSnapshotList(snapshots))
+	return nil
+}
+
+// Returns the details of a snapshot, with its subnets and blockchains.
+func formatSnapshotInfo(info *rpcpb.SnapshotInfo) string {
+	sb := &strings.Builder{}
+	w := tabwriter.NewWriter(sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME:\t%s\n", info.SnapshotName)
+	fmt.Fprintf(w, "FORMAT:\t%d\n", info.FormatVersion)
+	fmt.Fprintf(w, "CREATED:\t%s\n", time.UnixMilli(info.CreatedAt).Format(time.RFC3339))
+	fmt.Fprintf(w, "DESCRIPTION:\t%s\n", info.Description)
+	fmt.Fprintf(w, "LABELS:\t%s\n", formatLabels(info.Labels))
+	fmt.Fprintf(w, "ODYSSEYGO:\t%s\n", info.OdysseygoVersion)
+	fmt.Fprintf(w, "DATABASE:\t%s\n", info.DatabaseVersion)
+	fmt.Fprintf(w, "VMS:\t%s\n", formatLabels(info.VmVersions))
+	fmt.Fprintf(w, "NODES:\t%d\n", info.NumNodes)
+	fmt.Fprintf(w, "DB SIZE:\t%s\n", formatBytes(info.DbSize))
+	_ = w.Flush()
+	if len(info.Subnets) == 0 {
+		return sb.String()
+	}
+	fmt.Fprintln(sb, "SUBNETS:")
+	w = tabwriter.NewWriter(sb, 0, 0, 2, ' ', 0)
+	for _, subnet := range info.Subnets {
+		fmt.Fprintf(w, "  %s", subnet.SubnetId)
+		if subnet.ElasticSubnetId != "" {
+			fmt.Fprintf(w, " (elastic %s)", subnet.ElasticSubnetId)
+		}
+		fmt.Fprintln(w)
+		for _, blockchain := range subnet.Blockchains {
+			vmName := blockchain.VmName
+			if vmName == "" {
+				vmName = "-"
+			}
+			fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", blockchain.BlockchainId, blockchain.ChainName, vmName, blockchain.VmId)
+		}
+	}
+	_ = w.Flush()
+	return sb.String()
+}
+
+// Returns a table of snapshots.
+func formatSnapshotList(snapshots []*rpcpb.SnapshotInfo) string {
+	sb := &strings.Builder{}
+	w := tabwriter.NewWriter(sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCREATED\tNODES\tSUBNETS\tCHAINS\tDB SIZE\tODYSSEYGO\tLABELS\tDESCRIPTION")
+	for _, info := range snapshots {
+		numChains := 0
+		for _, subnet := range info.Subnets {
+			numChains += len(subnet.Blockchains)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\t%s\n",
+			info.SnapshotName,
+			time.UnixMilli(info.CreatedAt).Format(time.RFC3339),
+			info.NumNodes,
+			len(info.Subnets),
+			numChains,
+			formatBytes(info.DbSize),
+			info.OdysseygoVersion,
+			formatLabels(info.Labels),
+			info.Description,
+		)
+	}
+	_ = w.Flush()
+	return sb.String()
+}
+
+// Returns [labels] as sorted key=value pairs.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+var exportSnapshotOutput string
+
+func newExportSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-snapshot snapshot-name [options]",
+		Short: "Requests server to export a snapshot as a portable archive.",
+		RunE:  exportSnapshotFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().StringVar(
+		&exportSnapshotOutput,
+		"output",
+		"",
+		"[optional] path of the archive written (default \"<snapshot-name>.tar.gz\")",
+	)
+	return cmd
+}
+
+func exportSnapshotFunc(_ *cobra.Command, args []string) (err error) {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	output := exportSnapshotOutput
+	if output == "" {
+		output = args[0] + ".tar.gz"
+	}
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		// don't leave a partial archive around
+		if err != nil {
+			_ = os.Remove(output)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	err = cli.ExportSnapshot(ctx, args[0], f)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("snapshot %q exported to %s"), args[0], output)
+	return nil
+}
+
+var importSnapshotName string
+
+func newImportSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-snapshot archive-path [options]",
+		Short: "Requests server to verify and register a snapshot archive.",
+		RunE:  importSnapshotFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().StringVar(
+		&importSnapshotName,
+		"snapshot-name",
+		"",
+		"[optional] name the snapshot is registered with (default is the exported name)",
+	)
+	return cmd
+}
+
+func importSnapshotFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.ImportSnapshot(ctx, importSnapshotName, f)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("import-snapshot response: %+v"), resp)
+	return nil
+}
+
+func newPruneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune [options]",
+		Short: "Requests server to remove stale network root dirs, and the snapshots out of its retention.",
+		RunE:  pruneFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.PersistentFlags().StringVar(
+		&rootDataDir,
+		"root-data-dir",
+		"",
+		"[optional] dir of the network root dirs to prune (default is the runner dir of the server temp dir)",
+	)
+	cmd.PersistentFlags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"only print what would be removed",
+	)
+	return cmd
+}
+
+func pruneFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.Prune(ctx, client.WithRootDataDir(rootDataDir), client.WithDryRun(dryRun))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	action := "removed"
+	if dryRun {
+		action = "would remove"
+	}
+	for _, rootDir := range resp.RemovedRootDirs {
+		ux.Print(log, logging.Blue.Wrap("%s root dir %s"), action, rootDir)
+	}
+	for _, snapshotName := range resp.RemovedSnapshots {
+		ux.Print(log, logging.Blue.Wrap("%s snapshot %s"), action, snapshotName)
+	}
+	ux.Print(log, logging.Green.Wrap("%s %d root dirs (%s) and %d snapshots"),
+		action,
+		len(resp.RemovedRootDirs),
+		formatBytes(resp.ReclaimedBytes),
+		len(resp.RemovedSnapshots),
+	)
+	return nil
+}
+
+func newMigrateSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-snapshot snapshot-name [options]",
+		Short: "Requests server to upgrade a snapshot saved by an older runner to the current snapshot format.",
+		RunE:  migrateSnapshotFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"only print the migrations that would be applied",
+	)
+	return cmd
+}
+
+func newFundCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund address amount [options]",
+		Short: "Requests server to send an amount of DIONE (in nDIONE), or of an A-chain asset, to an A-, O- or D-chain address, from the EWOQ key.",
+		RunE:  fundFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().StringVar(
+		&fundAssetID,
+		"asset-id",
+		"",
+		"[optional] A-chain asset to send instead of DIONE. only DIONE can be sent to the D-chain",
+	)
+	return cmd
+}
+
+func fundFunc(_ *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", args[1], err)
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx := getAsyncContext()
+
+	resp, err := cli.Fund(ctx, args[0], amount, client.WithAssetID(fundAssetID))
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("funded %s with tx %s"), args[0], resp.TxId)
+	return nil
+}
+
+func newCrossChainTransferCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-chain-transfer source-chain destination-chain amount [options]",
+		Short: "Requests server to move an amount of DIONE (in nDIONE), or of an A-chain asset, between two of the A-, O- and D-chains.",
+		RunE:  crossChainTransferFunc,
+		Args:  cobra.ExactArgs(3),
+	}
+	cmd.PersistentFlags().StringVar(
+		&transferAssetID,
+		"asset-id",
+		"",
+		"[optional] A-chain asset to transfer instead of DIONE. only DIONE can be transferred from or to the D-chain",
+	)
+	cmd.PersistentFlags().StringVar(
+		&transferPrivateKey,
+		"private-key",
+		"",
+		"[optional] \"PrivateKey-\" prefixed key that owns the funds. the EWOQ key if empty",
+	)
+	return cmd
+}
+
+func crossChainTransferFunc(_ *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", args[2], err)
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx := getAsyncContext()
+
+	resp, err := cli.CrossChainTransfer(
+		ctx,
+		args[0],
+		args[1],
+		amount,
+		client.WithAssetID(transferAssetID),
+		client.WithPrivateKey(transferPrivateKey),
+	)
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("exported with tx %s, imported with tx %s"), resp.ExportTxId, resp.ImportTxId)
+	return nil
+}
+
+func migrateSnapshotFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.MigrateSnapshot(ctx, args[0], client.WithDryRun(dryRun))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	if len(resp.AppliedMigrations) == 0 {
+		ux.Print(log, logging.Green.Wrap("snapshot %q already has format version %d"), args[0], resp.ToVersion)
+		return nil
+	}
+	action := "applied"
+	if dryRun {
+		action = "would apply"
+	}
+	for _, migration := range resp.AppliedMigrations {
+		ux.Print(log, logging.Blue.Wrap("%s migration: %s"), action, migration)
+	}
+	ux.Print(log, logging.Green.Wrap("snapshot %q format version %d -> %d"), args[0], resp.FromVersion, resp.ToVersion)
+	return nil
+}
+
+func newGenKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-keys output-dir [options]",
+		Short: "Writes the staking keys derived from a seed, as given to the nodes started with it. Doesn't need the server.",
+		RunE:  genKeysFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().Int64Var(
+		&keySeed,
+		"key-seed",
+		0,
+		"seed the staking keys are derived from",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&numNodes,
+		"number-of-nodes",
+		local.DefaultNumNodes,
+		"number of nodes to write the keys of",
+	)
+	return cmd
+}
+
+func genKeysFunc(_ *cobra.Command, args []string) error {
+	if err := setLogs(); err != nil {
+		return err
+	}
+	for i := uint32(0); i < numNodes; i++ {
+		keys, err := local.NewNodeKeysFromSeed(keySeed, i)
+		if err != nil {
+			return err
+		}
+		// same names as the nodes of a started network
+		nodeName := fmt.Sprintf("node%d", i+1)
+		if err := local.WriteNodeKeys(filepath.Join(args[0], nodeName), keys); err != nil {
+			return err
+		}
+		ux.Print(log, logging.Blue.Wrap("%s: %s"), nodeName, keys.NodeID)
+	}
+	ux.Print(log, logging.Green.Wrap("wrote the keys of %d nodes to %s"), numNodes, args[0])
+	return nil
+}
+
+func newListNetworksCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-networks [options]",
+		Short: "Requests server to list the running networks.",
+		RunE:  listNetworksFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+}
+
+func listNetworksFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.ListNetworks(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("Networks: %s"), resp.NetworkNames)
+	return nil
+}
+
+var dryRun bool
+
+func newApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply manifest-path [options]",
+		Short: "Requests server to reconcile the network with a manifest.",
+		RunE:  applyFunc,
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&dryRun,
+		"dry-run",
+		false,
+		"only print the changes needed to reconcile the network, without applying them",
+	)
+	return cmd
+}
+
+func applyFunc(_ *cobra.Command, args []string) error {
+	manifestBytes, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.ApplyManifest(ctx, string(manifestBytes), client.WithDryRun(dryRun))
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Plan) == 0 {
+		ux.Print(log, logging.Green.Wrap("network already matches the manifest"))
+		return nil
+	}
+	for _, action := range resp.Plan {
+		ux.Print(log, logging.Blue.Wrap("plan: %s"), action)
+	}
+	if !dryRun {
+		ux.Print(log, logging.Green.Wrap("apply response: %+v"), resp)
+	}
+	return nil
+}
+
+func newPartitionNodesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partition-nodes node-names [node-names ...] [options]",
+		Short: "Splits the nodes into groups that can't connect to each other. Each group is a comma separated list of node names.",
+		RunE:  partitionNodesFunc,
+		Args:  cobra.MinimumNArgs(1),
+	}
+	return cmd
+}
+
+func partitionNodesFunc(_ *cobra.Command, args []string) error {
+	groups := make([][]string, 0, len(args))
+	for _, arg := range args {
+		groups = append(groups, strings.Split(arg, ","))
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.PartitionNodes(ctx, groups)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("partition nodes response: %+v"), info)
+	return nil
+}
+
+func newHealPartitionsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "heal-partitions [options]",
+		Short: "Removes all the node partitions.",
+		RunE:  healPartitionsFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	return cmd
+}
+
+func healPartitionsFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.HealPartitions(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("heal partitions response: %+v"), info)
+	return nil
+}
+
+var (
+	linkDelay  time.Duration
+	linkJitter time.Duration
+)
+
+func newSetLinkLatencyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-link-latency from-node-name to-node-name [options]",
+		Short: "Sets the latency of the traffic sent from a node to another.",
+		RunE:  setLinkLatencyFunc,
+		Args:  cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().DurationVar(
+		&linkDelay,
+		"delay",
+		0,
+		"delay added to the traffic. 0 removes the latency",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&linkJitter,
+		"jitter",
+		0,
+		"[optional] max random variation of the delay",
+	)
+	return cmd
+}
+
+func setLinkLatencyFunc(_ *cobra.Command, args []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.SetLinkLatency(ctx, args[0], args[1], linkDelay, linkJitter)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("set link latency response: %+v"), info)
+	return nil
+}
+
+func newSetLinkLossCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-link-loss from-node-name to-node-name loss [options]",
+		Short: "Sets the rate, in the range [0, 1), of packets lost on the traffic sent from a node to another.",
+		RunE:  setLinkLossFunc,
+		Args:  cobra.ExactArgs(3),
+	}
+	return cmd
+}
+
+func setLinkLossFunc(_ *cobra.Command, args []string) error {
+	loss, err := strconv.ParseFloat(args[2], 64)
+	if err != nil {
+		return fmt.Errorf("invalid loss %q: %w", args[2], err)
+	}
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	info, err := cli.SetLinkLoss(ctx, args[0], args[1], loss)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("set link loss response: %+v"), info)
+	return nil
+}
+
+var (
+	chaosPolicy      = &rpcpb.ChaosPolicy{}
+	chaosMinInterval time.Duration
+	chaosMaxInterval time.Duration
+	chaosMinDowntime time.Duration
+	chaosMaxDowntime time.Duration
+	chaosDuration    time.Duration
+)
+
+func newChaosCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chaos",
+		Short: "Random node disruptions over the network.",
+	}
+	cmd.AddCommand(
+		newStartChaosCommand(),
+		newStopChaosCommand(),
+		newChaosTimelineCommand(),
+	)
+	return cmd
+}
+
+func newStartChaosCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start [options]",
+		Short: "Starts disrupting random nodes of the network.",
+		RunE:  startChaosFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&chaosPolicy.NodeNames,
+		"nodes",
+		nil,
+		"[optional] comma separated names of the nodes that may be disrupted. All by default",
+	)
+	cmd.PersistentFlags().Uint32Var(
+		&chaosPolicy.MaxDown,
+		"max-down",
+		1,
+		"max number of nodes paused or removed at the same time",
+	)
+	cmd.PersistentFlags().StringVar(
+		&chaosPolicy.IntervalDistribution,
+		"distribution",
+		"uniform",
+		"distribution of the intervals between disruptions: uniform or exponential",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&chaosMinInterval,
+		"min-interval",
+		30*time.Second,
+		"min interval between disruptions",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&chaosMaxInterval,
+		"max-interval",
+		2*time.Minute,
+		"max interval between disruptions. For the exponential distribution, min interval plus the mean of the exponential part",
+	)
+	cmd.PersistentFlags().StringSliceVar(
+		&chaosPolicy.Disruptions,
+		"disruptions",
+		[]string{"pause"},
+		"comma separated disruption types to choose from: pause, restart, remove",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&chaosMinDowntime,
+		"min-downtime",
+		10*time.Second,
+		"min time a paused node stays down",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&chaosMaxDowntime,
+		"max-downtime",
+		time.Minute,
+		"max time a paused node stays down",
+	)
+	cmd.PersistentFlags().Int64Var(
+		&chaosPolicy.Seed,
+		"seed",
+		0,
+		"[optional] seed of the random choices, to reproduce a previous run. Random by default",
+	)
+	cmd.PersistentFlags().DurationVar(
+		&chaosDuration,
+		"duration",
+		0,
+		"[optional] time after which no more disruptions are executed. Until stopped by default",
+	)
+	return cmd
+}
+
+func startChaosFunc(*cobra.Command, []string) error {
+	chaosPolicy.MinIntervalMs = uint64(chaosMinInterval.Milliseconds())
+	chaosPolicy.MaxIntervalMs = uint64(chaosMaxInterval.Milliseconds())
+	chaosPolicy.MinDowntimeMs = uint64(chaosMinDowntime.Milliseconds())
+	chaosPolicy.MaxDowntimeMs = uint64(chaosMaxDowntime.Milliseconds())
+	chaosPolicy.DurationMs = uint64(chaosDuration.Milliseconds())
+
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.StartChaos(ctx, chaosPolicy)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("chaos started with seed %d, policy: %+v"), resp.Policy.Seed, resp.Policy)
+	return nil
+}
+
+func newStopChaosCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop [options]",
+		Short: "Stops disrupting nodes, resuming the paused ones.",
+		RunE:  stopChaosFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	return cmd
+}
+
+func stopChaosFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.StopChaos(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	printChaosTimeline(resp.Timeline)
+	return nil
+}
+
+func newChaosTimelineCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeline [options]",
+		Short: "Prints the disruptions executed so far.",
+		RunE:  chaosTimelineFunc,
+		Args:  cobra.ExactArgs(0),
+	}
+	return cmd
+}
+
+func chaosTimelineFunc(*cobra.Command, []string) error {
+	cli, err := newClient()
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	resp, err := cli.GetChaosTimeline(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	ux.Print(log, logging.Green.Wrap("chaos running: %t, seed: %d"), resp.Running, resp.Policy.Seed)
+	printChaosTimeline(resp.Timeline)
+	return nil
+}
+
+func printChaosTimeline(timeline []*rpcpb.ChaosEvent) {
+	for _, event := range timeline {
+		eventTime := time.UnixMilli(event.Timestamp).Format(time.RFC3339)
+		if event.Error != "" {
+			ux.Print(log, logging.Red.Wrap("%s %s %s failed: %s"), eventTime, event.Action, event.NodeName, event.Error)
+			continue
+		}
+		ux.Print(log, logging.Blue.Wrap("%s %s %s"), eventTime, event.Action, event.NodeName)
+	}
+}
+
 func newClient() (client.Client, error) {
 	if err := setLogs(); err != nil {
 		return nil, err
@@ -1311,6 +2836,7 @@ func newClient() (client.Client, error) {
 	return client.New(client.Config{
 		Endpoint:    endpoint,
 		DialTimeout: dialTimeout,
+		NetworkName: networkName,
 	}, log)
 }
 
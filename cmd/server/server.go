@@ -11,6 +11,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/DioneProtocol/odyssey-network-runner/local"
 	"github.com/DioneProtocol/odyssey-network-runner/server"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
 	"github.com/DioneProtocol/odyssey-network-runner/utils/constants"
@@ -34,6 +35,8 @@ var (
 	dialTimeout        time.Duration
 	disableNodesOutput bool
 	snapshotsDir       string
+	snapshotStore      string
+	snapshotRetention  local.SnapshotRetention
 )
 
 func NewCommand() *cobra.Command {
@@ -52,11 +55,16 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().DurationVar(&dialTimeout, "dial-timeout", 10*time.Second, "server dial timeout")
 	cmd.PersistentFlags().BoolVar(&disableNodesOutput, "disable-nodes-output", false, "true to disable nodes stdout/stderr")
 	cmd.PersistentFlags().StringVar(&snapshotsDir, "snapshots-dir", "", "directory for snapshots")
+	cmd.PersistentFlags().IntVar(&snapshotRetention.MaxCount, "snapshot-max-count", 0, "max number of snapshots kept, oldest are removed first (0 for no limit)")
+	cmd.PersistentFlags().DurationVar(&snapshotRetention.MaxAge, "snapshot-max-age", 0, "max age of the snapshots kept (0 for no limit)")
+	cmd.PersistentFlags().Int64Var(&snapshotRetention.MaxTotalSize, "snapshot-max-size", 0, "max total size in bytes of the snapshot DBs kept, oldest are removed first (0 for no limit)")
+	cmd.PersistentFlags().StringVar(&snapshotRetention.PinLabel, "snapshot-pin-label", local.DefaultSnapshotPinLabel, "snapshots with this label set to true are never removed by retention")
+	cmd.PersistentFlags().StringVar(&snapshotStore, "snapshot-store", "", "where to keep snapshots, as a directory or an s3://bucket/prefix?endpoint=...&region=... URL, snapshots-dir is then used as local copy (defaults to snapshots-dir)")
 
 	return cmd
 }
 
-func serverFunc(*cobra.Command, []string) (err error) {
+func serverFunc(cmd *cobra.Command, _ []string) (err error) {
 	if logDir == "" {
 		onrRootDir := filepath.Join(os.TempDir(), constants.RootDirPrefix)
 		err = os.MkdirAll(onrRootDir, os.ModePerm)
@@ -94,7 +102,10 @@ func serverFunc(*cobra.Command, []string) (err error) {
 		DialTimeout:         dialTimeout,
 		RedirectNodesOutput: !disableNodesOutput,
 		SnapshotsDir:        snapshotsDir,
+		SnapshotStore:       snapshotStore,
+		SnapshotRetention:   snapshotRetention,
 		LogLevel:            logLevel,
+		RunnerVersion:       cmd.Root().Version,
 	}, log)
 	if err != nil {
 		return err
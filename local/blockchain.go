@@ -30,12 +30,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/wallet/chain/d"
 	"github.com/DioneProtocol/odysseygo/wallet/chain/o"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
@@ -641,19 +643,34 @@ type wallet struct {
 	oBuilder o.Builder
 	oSigner  o.Signer
 	aWallet  a.Wallet
+	dWallet  d.Wallet
 }
 
+// Returns a wallet that issues txs with the EWOQ key
 func newWallet(
 	ctx context.Context,
 	uri string,
 	preloadTXs []ids.ID,
 ) (*wallet, error) {
-	kc := secp256k1fx.NewKeychain(genesis.EWOQKey)
+	return newWalletWithKey(ctx, uri, preloadTXs, genesis.EWOQKey)
+}
+
+func newWalletWithKey(
+	ctx context.Context,
+	uri string,
+	preloadTXs []ids.ID,
+	key *secp256k1.PrivateKey,
+) (*wallet, error) {
+	kc := secp256k1fx.NewKeychain(key)
 	primaryDIONEState, err := primary.FetchState(ctx, uri, kc.Addresses())
 	if err != nil {
 		return nil, err
 	}
-	oCTX, aCTX, utxos := primaryDIONEState.OCTX, primaryDIONEState.ACTX, primaryDIONEState.UTXOs
+	ethState, err := primary.FetchEthState(ctx, uri, kc.EthAddresses())
+	if err != nil {
+		return nil, err
+	}
+	oCTX, aCTX, dCTX, utxos := primaryDIONEState.OCTX, primaryDIONEState.ACTX, primaryDIONEState.DCTX, primaryDIONEState.UTXOs
 	oClient := omegavm.NewClient(uri)
 	oTXs := make(map[ids.ID]*txs.Tx)
 	for _, id := range preloadTXs {
@@ -671,7 +688,7 @@ func newWallet(
 	aChainID := aCTX.BlockchainID()
 	aUTXOs := primary.NewChainUTXOs(aChainID, utxos)
 	var w wallet
-	w.addr = genesis.EWOQKey.PublicKey().Address()
+	w.addr = key.PublicKey().Address()
 	w.oBackend = o.NewBackend(oCTX, oUTXOs, oTXs)
 	w.oBuilder = o.NewBuilder(kc.Addresses(), w.oBackend)
 	w.oSigner = o.NewSigner(kc, w.oBackend)
@@ -682,6 +699,12 @@ func newWallet(
 	aSigner := a.NewSigner(kc, aBackend)
 	aClient := alpha.NewClient(uri, "A")
 	w.aWallet = a.NewWallet(aBuilder, aSigner, aClient, aBackend)
+
+	dUTXOs := primary.NewChainUTXOs(dCTX.BlockchainID(), utxos)
+	dBackend := d.NewBackend(dCTX, dUTXOs, ethState.Accounts)
+	dBuilder := d.NewBuilder(kc.Addresses(), kc.EthAddresses(), dBackend)
+	dSigner := d.NewSigner(kc, kc, dBackend)
+	w.dWallet = d.NewWallet(dBuilder, dSigner, primaryDIONEState.DClient, ethState.Client, dBackend)
 	return &w, nil
 }
 
@@ -791,10 +814,10 @@ func getAChainAssetID(ctx context.Context, w *wallet, tokenName string, tokenSym
 	return tx.ID(), nil
 }
 
-func exportAChainToOChain(ctx context.Context, w *wallet, owner *secp256k1fx.OutputOwners, subnetAssetID ids.ID, assetAmount uint64) error {
+func exportAChainToOChain(ctx context.Context, w *wallet, owner *secp256k1fx.OutputOwners, subnetAssetID ids.ID, assetAmount uint64) (ids.ID, error) {
 	cctx, cancel := createDefaultCtx(ctx)
 	defer cancel()
-	_, err := w.aWallet.IssueExportTx(
+	tx, err := w.aWallet.IssueExportTx(
 		ids.Empty,
 		[]*dione.TransferableOutput{
 			{
@@ -810,22 +833,28 @@ func exportAChainToOChain(ctx context.Context, w *wallet, owner *secp256k1fx.Out
 		common.WithContext(cctx),
 		defaultPoll,
 	)
-	return err
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
 }
 
-func importOChainFromAChain(ctx context.Context, w *wallet, owner *secp256k1fx.OutputOwners) error {
+func importOChainFromAChain(ctx context.Context, w *wallet, owner *secp256k1fx.OutputOwners) (ids.ID, error) {
 	aWallet := w.aWallet
 	oWallet := w.oWallet
 	cctx, cancel := createDefaultCtx(ctx)
 	defer cancel()
 	aChainID := aWallet.BlockchainID()
-	_, err := oWallet.IssueImportTx(
+	tx, err := oWallet.IssueImportTx(
 		aChainID,
 		owner,
 		common.WithContext(cctx),
 		defaultPoll,
 	)
-	return err
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
 }
 
 func (ln *localNetwork) removeSubnetValidators(
@@ -1061,12 +1090,12 @@ func (ln *localNetwork) transformToElasticSubnets(
 				w.addr,
 			},
 		}
-		err = exportAChainToOChain(ctx, w, owner, subnetAssetID, elasticSubnetSpec.MaxSupply)
+		_, err = exportAChainToOChain(ctx, w, owner, subnetAssetID, elasticSubnetSpec.MaxSupply)
 		if err != nil {
 			return nil, nil, err
 		}
 		ln.log.Info("exported asset to O-Chain")
-		err = importOChainFromAChain(ctx, w, owner)
+		_, err = importOChainFromAChain(ctx, w, owner)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -0,0 +1,184 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidTransferAmount = errors.New("transfer amount must be positive")
+	ErrSameChainTransfer     = errors.New("source and destination chains must be different")
+)
+
+func (ln *localNetwork) CrossChainTransfer(
+	ctx context.Context,
+	transferSpec network.CrossChainTransferSpec,
+) (ids.ID, ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	return ln.crossChainTransfer(ctx, transferSpec)
+}
+
+// Exports [transferSpec.Amount] of the asset from the source chain, and
+// imports it into the destination chain, with the key of [transferSpec].
+// The import fee is paid with the imported DIONE, if any.
+// Returns the IDs of the export and import txs, once accepted.
+func (ln *localNetwork) crossChainTransfer(
+	ctx context.Context,
+	transferSpec network.CrossChainTransferSpec,
+) (ids.ID, ids.ID, error) {
+	if err := checkCrossChainTransferSpec(transferSpec); err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+	key, err := parsePrivateKey(transferSpec.PrivateKey)
+	if err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+	w, err := newWalletWithKey(ctx, clientURI, []ids.ID{}, key)
+	if err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+	dioneAssetID := w.aWallet.DIONEAssetID()
+	assetID := dioneAssetID
+	if transferSpec.AssetID != "" {
+		assetID, err = ids.FromString(transferSpec.AssetID)
+		if err != nil {
+			return ids.Empty, ids.Empty, fmt.Errorf("invalid asset ID %q: %w", transferSpec.AssetID, err)
+		}
+	}
+	// the D-chain wallet only moves DIONE
+	if assetID != dioneAssetID && (transferSpec.SourceChain == "D" || transferSpec.DestinationChain == "D") {
+		return ids.Empty, ids.Empty, ErrDChainNonDIONE
+	}
+	chainIDs := map[string]ids.ID{
+		"A": w.aWallet.BlockchainID(),
+		"O": constants.OmegaChainID,
+		"D": w.dWallet.BlockchainID(),
+	}
+	sourceChainID := chainIDs[transferSpec.SourceChain]
+	destinationChainID := chainIDs[transferSpec.DestinationChain]
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{w.addr},
+	}
+	ln.log.Info("transferring between chains",
+		zap.String("source-chain", transferSpec.SourceChain),
+		zap.String("destination-chain", transferSpec.DestinationChain),
+		zap.Stringer("asset-id", assetID),
+		zap.Uint64("amount", transferSpec.Amount),
+		zap.Stringer("address", w.addr),
+	)
+
+	outputs := []*dione.TransferableOutput{
+		{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          transferSpec.Amount,
+				OutputOwners: *owner,
+			},
+		},
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	var exportTxID ids.ID
+	switch transferSpec.SourceChain {
+	case "A":
+		tx, err := w.aWallet.IssueExportTx(destinationChainID, outputs, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return ids.Empty, ids.Empty, fmt.Errorf("couldn't export from the A-chain: %w", err)
+		}
+		exportTxID = tx.ID()
+	case "O":
+		tx, err := w.oWallet.IssueExportTx(destinationChainID, outputs, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return ids.Empty, ids.Empty, fmt.Errorf("couldn't export from the O-chain: %w", err)
+		}
+		exportTxID = tx.ID()
+	default:
+		// the D-chain exports DIONE from the eth address of the key
+		tx, err := w.dWallet.IssueExportTx(
+			destinationChainID,
+			[]*secp256k1fx.TransferOutput{outputs[0].Out.(*secp256k1fx.TransferOutput)},
+			common.WithContext(cctx),
+			defaultPoll,
+		)
+		if err != nil {
+			return ids.Empty, ids.Empty, fmt.Errorf("couldn't export from the D-chain: %w", err)
+		}
+		exportTxID = tx.ID()
+	}
+	ln.log.Info("exported funds", zap.Stringer("tx-id", exportTxID))
+
+	cctx, cancel = createDefaultCtx(ctx)
+	defer cancel()
+	var importTxID ids.ID
+	switch transferSpec.DestinationChain {
+	case "A":
+		tx, err := w.aWallet.IssueImportTx(sourceChainID, owner, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return exportTxID, ids.Empty, fmt.Errorf("couldn't import into the A-chain: %w", err)
+		}
+		importTxID = tx.ID()
+	case "O":
+		tx, err := w.oWallet.IssueImportTx(sourceChainID, owner, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return exportTxID, ids.Empty, fmt.Errorf("couldn't import into the O-chain: %w", err)
+		}
+		importTxID = tx.ID()
+	default:
+		ethAddr := ethcommon.Address(NewAccount(key).EthAddr)
+		tx, err := w.dWallet.IssueImportTx(sourceChainID, ethAddr, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return exportTxID, ids.Empty, fmt.Errorf("couldn't import into the D-chain: %w", err)
+		}
+		importTxID = tx.ID()
+	}
+	ln.log.Info("imported funds", zap.Stringer("tx-id", importTxID))
+	return exportTxID, importTxID, nil
+}
+
+func checkCrossChainTransferSpec(transferSpec network.CrossChainTransferSpec) error {
+	for _, chain := range []string{transferSpec.SourceChain, transferSpec.DestinationChain} {
+		if chain != "A" && chain != "O" && chain != "D" {
+			return fmt.Errorf("invalid chain %q, must be A, O or D", chain)
+		}
+	}
+	if transferSpec.SourceChain == transferSpec.DestinationChain {
+		return ErrSameChainTransfer
+	}
+	if transferSpec.Amount == 0 {
+		return ErrInvalidTransferAmount
+	}
+	return nil
+}
+
+// Parses a "PrivateKey-" prefixed key. Returns the EWOQ key if [privateKey]
+// is empty.
+func parsePrivateKey(privateKey string) (*secp256k1.PrivateKey, error) {
+	if privateKey == "" {
+		return genesis.EWOQKey, nil
+	}
+	key := &secp256k1.PrivateKey{}
+	if err := key.UnmarshalJSON([]byte(strconv.Quote(privateKey))); err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,89 @@
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCrossChainTransferSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        network.CrossChainTransferSpec
+		expectedErr error
+		errContains string
+	}{
+		{
+			name: "A to O",
+			spec: network.CrossChainTransferSpec{SourceChain: "A", DestinationChain: "O", Amount: 1},
+		},
+		{
+			name: "D to A",
+			spec: network.CrossChainTransferSpec{SourceChain: "D", DestinationChain: "A", Amount: 1},
+		},
+		{
+			name:        "invalid source chain",
+			spec:        network.CrossChainTransferSpec{SourceChain: "X", DestinationChain: "O", Amount: 1},
+			errContains: "invalid chain",
+		},
+		{
+			name:        "invalid destination chain",
+			spec:        network.CrossChainTransferSpec{SourceChain: "A", DestinationChain: "", Amount: 1},
+			errContains: "invalid chain",
+		},
+		{
+			name:        "same chain",
+			spec:        network.CrossChainTransferSpec{SourceChain: "O", DestinationChain: "O", Amount: 1},
+			expectedErr: ErrSameChainTransfer,
+		},
+		{
+			name:        "zero amount",
+			spec:        network.CrossChainTransferSpec{SourceChain: "A", DestinationChain: "D"},
+			expectedErr: ErrInvalidTransferAmount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCrossChainTransferSpec(tt.spec)
+			switch {
+			case tt.expectedErr != nil:
+				require.ErrorIs(t, err, tt.expectedErr)
+			case tt.errContains != "":
+				require.ErrorContains(t, err, tt.errContains)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	require := require.New(t)
+
+	key, err := parsePrivateKey("")
+	require.NoError(err)
+	require.Equal(genesis.EWOQKey, key)
+
+	key, err = parsePrivateKey(genesis.EWOQKey.String())
+	require.NoError(err)
+	require.Equal(genesis.EWOQKey.Address(), key.Address())
+
+	_, err = parsePrivateKey("PrivateKey-invalid")
+	require.ErrorContains(err, "invalid private key")
+	_, err = parsePrivateKey(genesis.EWOQKey.String()[len("PrivateKey-"):])
+	require.ErrorContains(err, "invalid private key")
+}
+
+func TestCrossChainTransferInvalidSpec(t *testing.T) {
+	ln := &localNetwork{log: logging.NoLog{}, networkID: constants.LocalID}
+
+	_, _, err := ln.CrossChainTransfer(context.Background(), network.CrossChainTransferSpec{SourceChain: "A", DestinationChain: "A", Amount: 1})
+	require.ErrorIs(t, err, ErrSameChainTransfer)
+	_, _, err = ln.CrossChainTransfer(context.Background(), network.CrossChainTransferSpec{SourceChain: "A", DestinationChain: "O", Amount: 1, PrivateKey: "invalid"})
+	require.ErrorContains(t, err, "invalid private key")
+}
@@ -0,0 +1,304 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	odygo_json "github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
+	"go.uber.org/zap"
+)
+
+// offset of delegation start from current time
+const delegationStartOffset = 30 * time.Second
+
+var (
+	ErrDelegatorStakeTooLow          = errors.New("delegator stake is below the minimum")
+	ErrInvalidDelegatorStakeDuration = errors.New("delegator stake duration is out of the allowed range")
+)
+
+func (ln *localNetwork) AddPermissionlessDelegators(
+	ctx context.Context,
+	delegatorSpecs []network.PermissionlessDelegatorSpec,
+) ([]ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	for _, delegatorSpec := range delegatorSpecs {
+		if delegatorSpec.SubnetID == "" {
+			return nil, fmt.Errorf("delegator spec of %s has no subnet ID", delegatorSpec.NodeName)
+		}
+	}
+	return ln.addDelegators(ctx, delegatorSpecs)
+}
+
+func (ln *localNetwork) AddPrimaryDelegators(
+	ctx context.Context,
+	delegatorSpecs []network.PrimaryDelegatorSpec,
+) ([]ids.ID, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	permissionlessDelegatorSpecs := make([]network.PermissionlessDelegatorSpec, len(delegatorSpecs))
+	for i, delegatorSpec := range delegatorSpecs {
+		permissionlessDelegatorSpecs[i] = network.PermissionlessDelegatorSpec{
+			NodeName:      delegatorSpec.NodeName,
+			StakedAmount:  delegatorSpec.StakedAmount,
+			StartTime:     delegatorSpec.StartTime,
+			StakeDuration: delegatorSpec.StakeDuration,
+		}
+	}
+	return ln.addDelegators(ctx, permissionlessDelegatorSpecs)
+}
+
+// Adds the delegators from the EWOQ key, to the primary network if the subnet
+// ID of a spec is empty. All the specs are checked against the staking rules of
+// their subnet before any tx is issued.
+// Returns the IDs of the txs, once accepted.
+func (ln *localNetwork) addDelegators(
+	ctx context.Context,
+	delegatorSpecs []network.PermissionlessDelegatorSpec,
+) ([]ids.ID, error) {
+	ln.log.Info("adding delegator txs")
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return nil, err
+	}
+	omegaCli := omegavm.NewClient(clientURI)
+	w, err := newWallet(ctx, clientURI, []ids.ID{})
+	if err != nil {
+		return nil, err
+	}
+
+	subnetValidators := make([]*txs.SubnetValidator, len(delegatorSpecs))
+	assetIDs := make([]ids.ID, len(delegatorSpecs))
+	for i, delegatorSpec := range delegatorSpecs {
+		node, ok := ln.nodes[delegatorSpec.NodeName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", network.ErrNodeNotFound, delegatorSpec.NodeName)
+		}
+		nodeID := node.GetNodeID()
+		subnetID := constants.PrimaryNetworkID
+		if delegatorSpec.SubnetID != "" {
+			subnetID, err = ids.FromString(delegatorSpec.SubnetID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		stakingRules, subnetAssetID, err := ln.getStakingRules(ctx, omegaCli, subnetID)
+		if err != nil {
+			return nil, err
+		}
+		assetIDs[i] = subnetAssetID
+		if delegatorSpec.AssetID != "" {
+			assetIDs[i], err = ids.FromString(delegatorSpec.AssetID)
+			if err != nil {
+				return nil, err
+			}
+			if assetIDs[i] != subnetAssetID {
+				return nil, fmt.Errorf("asset %s can't be staked on subnet %s, expected %s", assetIDs[i], subnetID, subnetAssetID)
+			}
+		}
+
+		validationStartTime, validationEndTime, err := getValidationPeriod(ctx, omegaCli, subnetID, nodeID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get the validation of %s: %w", delegatorSpec.NodeName, err)
+		}
+
+		startTime := delegatorSpec.StartTime
+		if startTime.IsZero() {
+			startTime = time.Now().Add(delegationStartOffset)
+			if startTime.Before(validationStartTime) {
+				startTime = validationStartTime
+			}
+		}
+		endTime := validationEndTime
+		if delegatorSpec.StakeDuration != 0 {
+			endTime = startTime.Add(delegatorSpec.StakeDuration)
+		}
+		if startTime.Before(validationStartTime) || endTime.After(validationEndTime) {
+			return nil, fmt.Errorf("delegation to %s is not within its validation, from %s to %s",
+				delegatorSpec.NodeName, validationStartTime, validationEndTime)
+		}
+		if err := checkDelegatorStake(stakingRules, delegatorSpec.StakedAmount, endTime.Sub(startTime)); err != nil {
+			return nil, fmt.Errorf("invalid delegation to %s: %w", delegatorSpec.NodeName, err)
+		}
+		subnetValidators[i] = &txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   delegatorSpec.StakedAmount,
+			},
+			Subnet: subnetID,
+		}
+	}
+
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{w.addr},
+	}
+	txIDs := make([]ids.ID, len(delegatorSpecs))
+	for i, delegatorSpec := range delegatorSpecs {
+		ln.log.Info(logging.Green.Wrap("adding delegator"), zap.String("node", delegatorSpec.NodeName), zap.Stringer("subnet-ID", subnetValidators[i].Subnet))
+		cctx, cancel := createDefaultCtx(ctx)
+		tx, err := w.oWallet.IssueAddPermissionlessDelegatorTx(
+			subnetValidators[i],
+			assetIDs[i],
+			owner,
+			common.WithContext(cctx),
+			defaultPoll,
+		)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("O-Wallet Tx Error %s %w, node %s", "IssueAddPermissionlessDelegatorTx", err, delegatorSpec.NodeName)
+		}
+		ln.log.Info("added delegator", zap.String("node", delegatorSpec.NodeName), zap.String("tx-ID", tx.ID().String()))
+		txIDs[i] = tx.ID()
+	}
+	return txIDs, nil
+}
+
+// Returns the start and end times of the current, or else pending, validation
+// of [nodeID] on [subnetID].
+func getValidationPeriod(
+	ctx context.Context,
+	omegaCli omegavm.Client,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+) (time.Time, time.Time, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	vs, err := omegaCli.GetCurrentValidators(cctx, subnetID, []ids.NodeID{nodeID})
+	cancel()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if len(vs) != 0 {
+		return time.Unix(int64(vs[0].StartTime), 0), time.Unix(int64(vs[0].EndTime), 0), nil
+	}
+	cctx, cancel = createDefaultCtx(ctx)
+	pendingVs, _, err := omegaCli.GetPendingValidators(cctx, subnetID, []ids.NodeID{nodeID})
+	cancel()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if len(pendingVs) == 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("node %s is not a validator of subnet %s", nodeID, subnetID)
+	}
+	// pending validators are returned as untyped JSON
+	b, err := json.Marshal(pendingVs[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	var staker struct {
+		StartTime odygo_json.Uint64 `json:"startTime"`
+		EndTime   odygo_json.Uint64 `json:"endTime"`
+	}
+	if err := json.Unmarshal(b, &staker); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return time.Unix(int64(staker.StartTime), 0), time.Unix(int64(staker.EndTime), 0), nil
+}
+
+// Returns the staking rules of [subnetID], and its staked asset.
+// The rules of the primary network are the ones of the network genesis, and the
+// rules of an elastic subnet are read from its transform subnet tx.
+func (ln *localNetwork) getStakingRules(
+	ctx context.Context,
+	omegaCli omegavm.Client,
+	subnetID ids.ID,
+) (network.ElasticSubnetSpec, ids.ID, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		cctx, cancel := createDefaultCtx(ctx)
+		dioneAssetID, err := omegaCli.GetStakingAssetID(cctx, constants.PrimaryNetworkID)
+		cancel()
+		if err != nil {
+			return network.ElasticSubnetSpec{}, ids.Empty, err
+		}
+		return primaryNetworkStakingRules(ln.networkID), dioneAssetID, nil
+	}
+	elasticSubnetID, ok := ln.subnetID2ElasticSubnetID[subnetID]
+	if !ok {
+		return network.ElasticSubnetSpec{}, ids.Empty, fmt.Errorf("subnet %s is not an elastic subnet", subnetID)
+	}
+	cctx, cancel := createDefaultCtx(ctx)
+	txBytes, err := omegaCli.GetTx(cctx, elasticSubnetID)
+	cancel()
+	if err != nil {
+		return network.ElasticSubnetSpec{}, ids.Empty, err
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return network.ElasticSubnetSpec{}, ids.Empty, err
+	}
+	transformSubnetTx, ok := tx.Unsigned.(*txs.TransformSubnetTx)
+	if !ok {
+		return network.ElasticSubnetSpec{}, ids.Empty, fmt.Errorf("tx %s is not a transform subnet tx", elasticSubnetID)
+	}
+	return elasticSubnetSpecFromTx(transformSubnetTx), transformSubnetTx.AssetID, nil
+}
+
+func primaryNetworkStakingRules(networkID uint32) network.ElasticSubnetSpec {
+	stakingConfig := genesis.GetStakingConfig(networkID)
+	return network.ElasticSubnetSpec{
+		MinValidatorStake:         stakingConfig.MinValidatorStake,
+		MaxValidatorStake:         stakingConfig.MaxValidatorStake,
+		MinValidatorStakeDuration: stakingConfig.MinValidatorStakeDuration,
+		MaxValidatorStakeDuration: stakingConfig.MaxValidatorStakeDuration,
+		MinDelegatorStakeDuration: stakingConfig.MinDelegatorStakeDuration,
+		MaxDelegatorStakeDuration: stakingConfig.MaxDelegatorStakeDuration,
+		MinDelegationFee:          stakingConfig.MinDelegationFee,
+		MinDelegatorStake:         stakingConfig.MinDelegatorStake,
+		UptimeRequirement:         uint32(stakingConfig.UptimeRequirement * reward.PercentDenominator),
+	}
+}
+
+func elasticSubnetSpecFromTx(tx *txs.TransformSubnetTx) network.ElasticSubnetSpec {
+	subnetID := tx.Subnet.String()
+	return network.ElasticSubnetSpec{
+		SubnetID:                  &subnetID,
+		InitialSupply:             tx.InitialSupply,
+		MaxSupply:                 tx.MaximumSupply,
+		MinConsumptionRate:        tx.MinConsumptionRate,
+		MaxConsumptionRate:        tx.MaxConsumptionRate,
+		MinValidatorStake:         tx.MinValidatorStake,
+		MaxValidatorStake:         tx.MaxValidatorStake,
+		MinValidatorStakeDuration: time.Duration(tx.MinValidatorStakeDuration) * time.Second,
+		MaxValidatorStakeDuration: time.Duration(tx.MaxValidatorStakeDuration) * time.Second,
+		MinDelegatorStakeDuration: time.Duration(tx.MinDelegatorStakeDuration) * time.Second,
+		MaxDelegatorStakeDuration: time.Duration(tx.MaxDelegatorStakeDuration) * time.Second,
+		MinDelegationFee:          tx.MinDelegationFee,
+		MinDelegatorStake:         tx.MinDelegatorStake,
+		MaxValidatorWeightFactor:  tx.MaxValidatorWeightFactor,
+		UptimeRequirement:         tx.UptimeRequirement,
+	}
+}
+
+// Checks a delegation of [stakedAmount] for [stakeDuration] against the
+// delegator minimums, and maximum duration, of [stakingRules].
+func checkDelegatorStake(stakingRules network.ElasticSubnetSpec, stakedAmount uint64, stakeDuration time.Duration) error {
+	if stakedAmount < stakingRules.MinDelegatorStake {
+		return fmt.Errorf("%w: %d < %d", ErrDelegatorStakeTooLow, stakedAmount, stakingRules.MinDelegatorStake)
+	}
+	if stakeDuration < stakingRules.MinDelegatorStakeDuration || stakeDuration > stakingRules.MaxDelegatorStakeDuration {
+		return fmt.Errorf("%w: %s not in [%s, %s]",
+			ErrInvalidDelegatorStakeDuration,
+			stakeDuration,
+			stakingRules.MinDelegatorStakeDuration,
+			stakingRules.MaxDelegatorStakeDuration,
+		)
+	}
+	return nil
+}
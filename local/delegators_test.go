@@ -0,0 +1,104 @@
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDelegatorStake(t *testing.T) {
+	stakingRules := network.ElasticSubnetSpec{
+		MinDelegatorStake:         units.Dione,
+		MinDelegatorStakeDuration: time.Hour,
+		MaxDelegatorStakeDuration: 24 * time.Hour,
+	}
+	tests := []struct {
+		name          string
+		stakedAmount  uint64
+		stakeDuration time.Duration
+		expectedErr   error
+	}{
+		{
+			name:          "minimums",
+			stakedAmount:  units.Dione,
+			stakeDuration: time.Hour,
+		},
+		{
+			name:          "maximum duration",
+			stakedAmount:  2 * units.Dione,
+			stakeDuration: 24 * time.Hour,
+		},
+		{
+			name:          "stake too low",
+			stakedAmount:  units.Dione - 1,
+			stakeDuration: time.Hour,
+			expectedErr:   ErrDelegatorStakeTooLow,
+		},
+		{
+			name:          "duration too short",
+			stakedAmount:  units.Dione,
+			stakeDuration: time.Hour - time.Second,
+			expectedErr:   ErrInvalidDelegatorStakeDuration,
+		},
+		{
+			name:          "duration too long",
+			stakedAmount:  units.Dione,
+			stakeDuration: 24*time.Hour + time.Second,
+			expectedErr:   ErrInvalidDelegatorStakeDuration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDelegatorStake(stakingRules, tt.stakedAmount, tt.stakeDuration)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestElasticSubnetSpecFromTx(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	spec := elasticSubnetSpecFromTx(&txs.TransformSubnetTx{
+		Subnet:                    subnetID,
+		MinValidatorStake:         2 * units.Dione,
+		MinDelegatorStake:         units.Dione,
+		MinDelegatorStakeDuration: 3600,
+		MaxDelegatorStakeDuration: 86400,
+		MinDelegationFee:          20000,
+		MaxValidatorWeightFactor:  5,
+	})
+	require.Equal(subnetID.String(), *spec.SubnetID)
+	require.Equal(2*units.Dione, spec.MinValidatorStake)
+	require.Equal(units.Dione, spec.MinDelegatorStake)
+	require.Equal(time.Hour, spec.MinDelegatorStakeDuration)
+	require.Equal(24*time.Hour, spec.MaxDelegatorStakeDuration)
+	require.Equal(uint32(20000), spec.MinDelegationFee)
+	require.Equal(byte(5), spec.MaxValidatorWeightFactor)
+}
+
+func TestPrimaryNetworkStakingRules(t *testing.T) {
+	require := require.New(t)
+
+	stakingRules := primaryNetworkStakingRules(constants.LocalID)
+	require.Equal(genesis.LocalParams.MinDelegatorStake, stakingRules.MinDelegatorStake)
+	require.Equal(genesis.LocalParams.MinDelegatorStakeDuration, stakingRules.MinDelegatorStakeDuration)
+	require.Equal(genesis.LocalParams.MaxDelegatorStakeDuration, stakingRules.MaxDelegatorStakeDuration)
+	require.Equal(uint32(800000), stakingRules.UptimeRequirement)
+}
+
+func TestAddPermissionlessDelegatorsNoSubnetID(t *testing.T) {
+	ln := &localNetwork{log: logging.NoLog{}, networkID: constants.LocalID}
+
+	_, err := ln.AddPermissionlessDelegators(context.Background(), []network.PermissionlessDelegatorSpec{{NodeName: "node1"}})
+	require.ErrorContains(t, err, "has no subnet ID")
+}
@@ -0,0 +1,567 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	psnet "github.com/shirou/gopsutil/net"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// When fault injection is enabled, nodes listen for p2p connections on
+	// [faultProxyNodeHost], and the proxy listens on the same port on
+	// [faultProxyHost], which is the address advertised to the peers.
+	faultProxyHost     = "127.0.0.1"
+	faultProxyNodeHost = "127.0.0.2"
+	// As links are TCP streams, a lost packet is emulated by delaying
+	// the data for a retransmission timeout.
+	lossRetransmitDelay = 200 * time.Millisecond
+	// Max number of chunks buffered on a proxied connection direction.
+	maxPendingChunks = 1024
+	proxyBufferSize  = 32 * 1024
+)
+
+var (
+	errInvalidLoss    = errors.New("loss must be in the range [0, 1)")
+	errInvalidLatency = errors.New("jitter can't be greater than delay")
+)
+
+// link is the direction of traffic from one node to another.
+type link struct {
+	from string
+	to   string
+}
+
+type linkFaults struct {
+	delay  time.Duration
+	jitter time.Duration
+	loss   float64
+}
+
+// faultProxy sits in front of the p2p port of every node of a network,
+// and injects partitions, latency and packet loss into the links between them.
+type faultProxy struct {
+	log  logging.Logger
+	lock sync.Mutex
+	rand *rand.Rand
+	// node name --> proxy listener
+	listeners map[string]net.Listener
+	// node name --> p2p port
+	ports map[string]uint16
+	// node name --> process, used to find the source node of connections
+	processes map[string]NodeProcess
+	// node name --> partition group. Nodes not in any group
+	// belong to an implicit extra group.
+	groups map[string]int
+	links  map[link]linkFaults
+	conns  map[*proxyConn]struct{}
+	// Returns the name of the node that opened the connection coming from [addr],
+	// or the empty string if it is not a node of the network.
+	sourceNodeF func(addr net.Addr) string
+}
+
+// proxyConn is a connection from node [from] to node [to] going through the proxy.
+type proxyConn struct {
+	from   string
+	to     string
+	client net.Conn
+	server net.Conn
+}
+
+func (c *proxyConn) close() {
+	_ = c.client.Close()
+	_ = c.server.Close()
+}
+
+func newFaultProxy(log logging.Logger) *faultProxy {
+	p := &faultProxy{
+		log:       log,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+		listeners: map[string]net.Listener{},
+		ports:     map[string]uint16{},
+		processes: map[string]NodeProcess{},
+		groups:    map[string]int{},
+		links:     map[link]linkFaults{},
+		conns:     map[*proxyConn]struct{}{},
+	}
+	p.sourceNodeF = p.getSourceNode
+	return p
+}
+
+// Starts proxying the connections to [port] on [faultProxyHost] to
+// the node [nodeName], listening on [port] on [faultProxyNodeHost].
+// If the node was already proxied, the previous listener is closed.
+func (p *faultProxy) addNode(nodeName string, port uint16) error {
+	p.removeNode(nodeName)
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(faultProxyHost, fmt.Sprintf("%d", port)))
+	if err != nil {
+		return fmt.Errorf("couldn't start fault proxy for node %q: %w", nodeName, err)
+	}
+
+	p.lock.Lock()
+	p.listeners[nodeName] = listener
+	p.ports[nodeName] = port
+	p.lock.Unlock()
+
+	go p.accept(nodeName, listener, port)
+	return nil
+}
+
+// Sets the process of the proxied node [nodeName], whose connections to
+// the other nodes are identified by it.
+func (p *faultProxy) setNodeProcess(nodeName string, process NodeProcess) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.listeners[nodeName]; ok {
+		p.processes[nodeName] = process
+	}
+}
+
+// Stops proxying the connections to [nodeName], and closes the existing ones.
+func (p *faultProxy) removeNode(nodeName string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if listener, ok := p.listeners[nodeName]; ok {
+		_ = listener.Close()
+	}
+	delete(p.listeners, nodeName)
+	delete(p.ports, nodeName)
+	delete(p.processes, nodeName)
+	for conn := range p.conns {
+		if conn.from == nodeName || conn.to == nodeName {
+			conn.close()
+			delete(p.conns, conn)
+		}
+	}
+}
+
+// Stops proxying all the nodes.
+func (p *faultProxy) close() {
+	p.lock.Lock()
+	nodeNames := make([]string, 0, len(p.listeners))
+	for nodeName := range p.listeners {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	p.lock.Unlock()
+	for _, nodeName := range nodeNames {
+		p.removeNode(nodeName)
+	}
+}
+
+func (p *faultProxy) accept(nodeName string, listener net.Listener, port uint16) {
+	for {
+		client, err := listener.Accept()
+		if err != nil {
+			// listener closed
+			return
+		}
+		go p.handle(nodeName, client, port)
+	}
+}
+
+func (p *faultProxy) handle(nodeName string, client net.Conn, port uint16) {
+	from := p.sourceNodeF(client.RemoteAddr())
+
+	p.lock.Lock()
+	blocked := p.isPartitioned(from, nodeName)
+	p.lock.Unlock()
+	if blocked {
+		p.log.Debug("fault proxy dropping connection", zap.String("from", from), zap.String("to", nodeName))
+		_ = client.Close()
+		return
+	}
+
+	server, err := net.Dial("tcp", net.JoinHostPort(faultProxyNodeHost, fmt.Sprintf("%d", port)))
+	if err != nil {
+		p.log.Debug("fault proxy couldn't reach node", zap.String("node", nodeName), zap.Error(err))
+		_ = client.Close()
+		return
+	}
+
+	conn := &proxyConn{
+		from:   from,
+		to:     nodeName,
+		client: client,
+		server: server,
+	}
+	p.lock.Lock()
+	// the node may have been removed, or partitioned, meanwhile
+	if _, ok := p.listeners[nodeName]; !ok || p.isPartitioned(from, nodeName) {
+		p.lock.Unlock()
+		conn.close()
+		return
+	}
+	p.conns[conn] = struct{}{}
+	p.lock.Unlock()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		p.pipe(server, client, link{from: from, to: nodeName})
+	}()
+	go func() {
+		defer wg.Done()
+		p.pipe(client, server, link{from: nodeName, to: from})
+	}()
+	wg.Wait()
+
+	p.lock.Lock()
+	delete(p.conns, conn)
+	p.lock.Unlock()
+}
+
+type chunk struct {
+	data      []byte
+	deliverAt time.Time
+}
+
+// Copies [src] into [dst], delaying the data as given by the faults of [l].
+// Data is delivered in order. Both connections are closed on return.
+func (p *faultProxy) pipe(dst net.Conn, src net.Conn, l link) {
+	chunks := make(chan chunk, maxPendingChunks)
+	go func() {
+		defer close(chunks)
+		lastDeliverAt := time.Time{}
+		for {
+			buf := make([]byte, proxyBufferSize)
+			n, err := src.Read(buf)
+			if n > 0 {
+				deliverAt := time.Now().Add(p.getDelay(l))
+				if deliverAt.Before(lastDeliverAt) {
+					deliverAt = lastDeliverAt
+				}
+				lastDeliverAt = deliverAt
+				chunks <- chunk{data: buf[:n], deliverAt: deliverAt}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	for c := range chunks {
+		time.Sleep(time.Until(c.deliverAt))
+		if _, err := dst.Write(c.data); err != nil {
+			break
+		}
+	}
+	_ = src.Close()
+	_ = dst.Close()
+	// unblock the reader until it notices [src] is closed
+	for range chunks { //nolint:revive
+	}
+}
+
+// Returns the delay to apply to the next chunk of data sent over [l].
+func (p *faultProxy) getDelay(l link) time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	faults, ok := p.links[l]
+	if !ok {
+		return 0
+	}
+	delay := faults.delay
+	if faults.jitter > 0 {
+		delay += time.Duration(p.rand.Int63n(int64(2*faults.jitter)+1)) - faults.jitter
+	}
+	for faults.loss > 0 && p.rand.Float64() < faults.loss {
+		delay += lossRetransmitDelay
+	}
+	return delay
+}
+
+// Splits the nodes into [groups] that can't connect to each other.
+// Nodes not included in any group form an extra group. Existing connections
+// between different groups are closed.
+func (p *faultProxy) partition(groups [][]string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.groups = map[string]int{}
+	for i, group := range groups {
+		for _, nodeName := range group {
+			p.groups[nodeName] = i
+		}
+	}
+	p.closePartitionedConns()
+}
+
+// Closes the connections whose nodes can't connect anymore.
+// Assumes [p.lock] is held.
+func (p *faultProxy) closePartitionedConns() {
+	for conn := range p.conns {
+		if p.isPartitioned(conn.from, conn.to) {
+			conn.close()
+			delete(p.conns, conn)
+		}
+	}
+}
+
+// Removes all partitions.
+func (p *faultProxy) heal() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.groups = map[string]int{}
+}
+
+// Sets the latency of the traffic sent from [from] to [to].
+// A zero [delay] removes the latency.
+func (p *faultProxy) setLatency(from string, to string, delay time.Duration, jitter time.Duration) error {
+	if jitter > delay {
+		return errInvalidLatency
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	l := link{from: from, to: to}
+	faults := p.links[l]
+	faults.delay = delay
+	faults.jitter = jitter
+	p.setLinkFaults(l, faults)
+	return nil
+}
+
+// Sets the rate of packets lost on the traffic sent from [from] to [to].
+// A zero [loss] removes the loss.
+func (p *faultProxy) setLoss(from string, to string, loss float64) error {
+	if loss < 0 || loss >= 1 {
+		return errInvalidLoss
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	l := link{from: from, to: to}
+	faults := p.links[l]
+	faults.loss = loss
+	p.setLinkFaults(l, faults)
+	return nil
+}
+
+// Assumes [p.lock] is held.
+func (p *faultProxy) setLinkFaults(l link, faults linkFaults) {
+	if faults == (linkFaults{}) {
+		delete(p.links, l)
+		return
+	}
+	p.links[l] = faults
+	// connections from unknown sources would bypass the faults
+	p.closePartitionedConns()
+}
+
+// Returns true if [from] can't connect to [to].
+// Connections from unknown sources are rejected while there are partitions,
+// or link faults on [to], as they could bypass them.
+// Assumes [p.lock] is held.
+func (p *faultProxy) isPartitioned(from string, to string) bool {
+	if from == "" {
+		return len(p.groups) != 0 || p.hasLinkFaults(to)
+	}
+	if len(p.groups) == 0 {
+		return false
+	}
+	fromGroup, ok := p.groups[from]
+	if !ok {
+		fromGroup = -1
+	}
+	toGroup, ok := p.groups[to]
+	if !ok {
+		toGroup = -1
+	}
+	return fromGroup != toGroup
+}
+
+// Returns true if there are faults on the links from or to [nodeName].
+// Assumes [p.lock] is held.
+func (p *faultProxy) hasLinkFaults(nodeName string) bool {
+	for l := range p.links {
+		if l.from == nodeName || l.to == nodeName {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the name of the node whose process owns the socket at [addr].
+// Only the sockets of the node processes are looked up.
+func (p *faultProxy) getSourceNode(addr net.Addr) string {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return ""
+	}
+	p.lock.Lock()
+	processes := maps.Clone(p.processes)
+	p.lock.Unlock()
+
+	for nodeName, process := range processes {
+		pid := process.Pid()
+		if pid == 0 {
+			continue
+		}
+		owned, err := ownsSocket(int32(pid), tcpAddr)
+		if err != nil {
+			p.log.Debug("fault proxy couldn't list node connections", zap.String("node", nodeName), zap.Error(err))
+			continue
+		}
+		if owned {
+			return nodeName
+		}
+	}
+	return ""
+}
+
+// Returns true if the process [pid] has a connected socket at [addr].
+func ownsSocket(pid int32, addr *net.TCPAddr) (bool, error) {
+	conns, err := psnet.ConnectionsPid("tcp", pid)
+	if err != nil {
+		return false, err
+	}
+	for _, conn := range conns {
+		if conn.Laddr.Port == uint32(addr.Port) && conn.Laddr.IP == addr.IP.String() && conn.Status != "LISTEN" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns an error if the connections between nodes can't be proxied on
+// this host: nodes must be able to listen on [faultProxyNodeHost], and
+// the process owning a connection must be identifiable.
+func checkFaultProxySupport() error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(faultProxyNodeHost, "0"))
+	if err != nil {
+		return fmt.Errorf(
+			"fault injection needs the loopback address %s, which on macOS must first be added with \"sudo ifconfig lo0 alias %s up\": %w",
+			faultProxyNodeHost,
+			faultProxyNodeHost,
+			err,
+		)
+	}
+	defer listener.Close()
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		return fmt.Errorf("fault injection couldn't connect to %s: %w", faultProxyNodeHost, err)
+	}
+	defer conn.Close()
+	owned, err := ownsSocket(int32(os.Getpid()), conn.LocalAddr().(*net.TCPAddr))
+	if err != nil {
+		return fmt.Errorf("fault injection can't find the process of connections on this host: %w", err)
+	}
+	if !owned {
+		return errors.New("fault injection can't find the process of connections on this host")
+	}
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) PartitionNodes(_ context.Context, groups [][]string) error {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if err := ln.checkFaultInjection(); err != nil {
+		return err
+	}
+	for _, group := range groups {
+		for _, nodeName := range group {
+			if _, ok := ln.nodes[nodeName]; !ok {
+				return fmt.Errorf("%w: %q", network.ErrNodeNotFound, nodeName)
+			}
+		}
+	}
+	ln.log.Info("partitioning nodes", zap.Any("groups", groups))
+	ln.faultProxy.partition(groups)
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) HealPartitions(context.Context) error {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if err := ln.checkFaultInjection(); err != nil {
+		return err
+	}
+	ln.log.Info("healing partitions")
+	ln.faultProxy.heal()
+	return nil
+}
+
+// See network.Network
+func (ln *localNetwork) SetLinkLatency(
+	_ context.Context,
+	from string,
+	to string,
+	delay time.Duration,
+	jitter time.Duration,
+) error {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if err := ln.checkLink(from, to); err != nil {
+		return err
+	}
+	ln.log.Info("setting link latency",
+		zap.String("from", from),
+		zap.String("to", to),
+		zap.Duration("delay", delay),
+		zap.Duration("jitter", jitter),
+	)
+	return ln.faultProxy.setLatency(from, to, delay, jitter)
+}
+
+// See network.Network
+func (ln *localNetwork) SetLinkLoss(_ context.Context, from string, to string, loss float64) error {
+	ln.lock.RLock()
+	defer ln.lock.RUnlock()
+
+	if err := ln.checkLink(from, to); err != nil {
+		return err
+	}
+	ln.log.Info("setting link loss",
+		zap.String("from", from),
+		zap.String("to", to),
+		zap.Float64("loss", loss),
+	)
+	return ln.faultProxy.setLoss(from, to, loss)
+}
+
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) checkFaultInjection() error {
+	if ln.stopCalled() {
+		return network.ErrStopped
+	}
+	if ln.faultProxy == nil {
+		return network.ErrFaultInjectionDisabled
+	}
+	return nil
+}
+
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) checkLink(from string, to string) error {
+	if err := ln.checkFaultInjection(); err != nil {
+		return err
+	}
+	for _, nodeName := range []string{from, to} {
+		if _, ok := ln.nodes[nodeName]; !ok {
+			return fmt.Errorf("%w: %q", network.ErrNodeNotFound, nodeName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/local/mocks"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// Starts an echo server on [faultProxyNodeHost], proxied by [p] as [nodeName],
+// and returns its port.
+func startEchoNode(t *testing.T, p *faultProxy, nodeName string) uint16 {
+	require := require.New(t)
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(faultProxyNodeHost, "0"))
+	require.NoError(err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	port := uint16(listener.Addr().(*net.TCPAddr).Port)
+	require.NoError(p.addNode(nodeName, port))
+	return port
+}
+
+func dialProxy(t *testing.T, port uint16) net.Conn {
+	conn, err := net.Dial("tcp", net.JoinHostPort(faultProxyHost, fmt.Sprint(port)))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// Returns the time needed to echo a message through [conn], or an error
+// if the connection is closed.
+func echo(conn net.Conn) (time.Duration, error) {
+	start := time.Now()
+	msg := []byte("ping")
+	if _, err := conn.Write(msg); err != nil {
+		return 0, err
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := io.ReadFull(conn, msg); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+func newTestFaultProxy(t *testing.T, sourceNode string) *faultProxy {
+	p := newFaultProxy(logging.NoLog{})
+	p.sourceNodeF = func(net.Addr) string {
+		return sourceNode
+	}
+	t.Cleanup(p.close)
+	return p
+}
+
+func TestFaultProxyPartition(t *testing.T) {
+	require := require.New(t)
+
+	p := newTestFaultProxy(t, "node1")
+	port := startEchoNode(t, p, "node2")
+
+	conn := dialProxy(t, port)
+	_, err := echo(conn)
+	require.NoError(err)
+
+	// existing connections between groups are closed
+	p.partition([][]string{{"node1"}, {"node2"}})
+	_, err = echo(conn)
+	require.Error(err)
+
+	// new connections are rejected
+	_, err = echo(dialProxy(t, port))
+	require.Error(err)
+
+	// nodes not in any group form their own group
+	p.partition([][]string{{"node3"}})
+	_, err = echo(dialProxy(t, port))
+	require.NoError(err)
+
+	p.partition([][]string{{"node1", "node2"}})
+	_, err = echo(dialProxy(t, port))
+	require.NoError(err)
+
+	p.partition([][]string{{"node2"}})
+	_, err = echo(dialProxy(t, port))
+	require.Error(err)
+
+	p.heal()
+	_, err = echo(dialProxy(t, port))
+	require.NoError(err)
+}
+
+// Connections from unknown sources can't bypass the faults
+func TestFaultProxyUnknownSource(t *testing.T) {
+	require := require.New(t)
+
+	p := newTestFaultProxy(t, "")
+	port := startEchoNode(t, p, "node2")
+	conn := dialProxy(t, port)
+	_, err := echo(conn)
+	require.NoError(err)
+
+	p.partition([][]string{{"node1"}, {"node2"}})
+	_, err = echo(conn)
+	require.Error(err)
+	_, err = echo(dialProxy(t, port))
+	require.Error(err)
+
+	p.heal()
+	conn = dialProxy(t, port)
+	_, err = echo(conn)
+	require.NoError(err)
+
+	require.NoError(p.setLatency("node2", "node1", time.Millisecond, 0))
+	_, err = echo(conn)
+	require.Error(err)
+	_, err = echo(dialProxy(t, port))
+	require.Error(err)
+
+	// faults on other links don't matter
+	require.NoError(p.setLatency("node2", "node1", 0, 0))
+	require.NoError(p.setLatency("node1", "node3", time.Millisecond, 0))
+	_, err = echo(dialProxy(t, port))
+	require.NoError(err)
+}
+
+func TestFaultProxySourceNode(t *testing.T) {
+	require := require.New(t)
+	require.NoError(checkFaultProxySupport())
+
+	p := newFaultProxy(logging.NoLog{})
+	t.Cleanup(p.close)
+	port := startEchoNode(t, p, "node2")
+	startEchoNode(t, p, "node1")
+
+	// the connections opened by this process are identified as node1's
+	process := &mocks.NodeProcess{}
+	process.On("Pid").Return(os.Getpid())
+	p.setNodeProcess("node1", process)
+	conn := dialProxy(t, port)
+	require.Equal("node1", p.getSourceNode(conn.LocalAddr()))
+
+	p.partition([][]string{{"node1", "node2"}})
+	_, err := echo(conn)
+	require.NoError(err)
+	p.partition([][]string{{"node1"}, {"node2"}})
+	_, err = echo(dialProxy(t, port))
+	require.Error(err)
+
+	// a removed node is no longer identified
+	p.removeNode("node1")
+	require.Empty(p.getSourceNode(dialProxy(t, port).LocalAddr()))
+}
+
+func TestFaultProxyLatency(t *testing.T) {
+	require := require.New(t)
+
+	p := newTestFaultProxy(t, "node1")
+	port := startEchoNode(t, p, "node2")
+	conn := dialProxy(t, port)
+
+	delay := 200 * time.Millisecond
+	require.NoError(p.setLatency("node1", "node2", delay, 0))
+	elapsed, err := echo(conn)
+	require.NoError(err)
+	require.GreaterOrEqual(elapsed, delay)
+
+	// both directions add up
+	require.NoError(p.setLatency("node2", "node1", delay, 0))
+	elapsed, err = echo(conn)
+	require.NoError(err)
+	require.GreaterOrEqual(elapsed, 2*delay)
+
+	// zero delay removes the latency
+	require.NoError(p.setLatency("node1", "node2", 0, 0))
+	require.NoError(p.setLatency("node2", "node1", 0, 0))
+	require.Empty(p.links)
+	elapsed, err = echo(conn)
+	require.NoError(err)
+	require.Less(elapsed, delay)
+}
+
+func TestFaultProxyLoss(t *testing.T) {
+	require := require.New(t)
+
+	p := newTestFaultProxy(t, "node1")
+	l := link{from: "node1", to: "node2"}
+
+	require.ErrorIs(p.setLoss(l.from, l.to, 1), errInvalidLoss)
+	require.ErrorIs(p.setLoss(l.from, l.to, -0.1), errInvalidLoss)
+	require.ErrorIs(p.setLatency(l.from, l.to, time.Second, 2*time.Second), errInvalidLatency)
+
+	require.Zero(p.getDelay(l))
+	require.NoError(p.setLoss(l.from, l.to, 0.99))
+	// the delay is a multiple of the retransmission delay
+	delay := p.getDelay(l)
+	require.Zero(delay % lossRetransmitDelay)
+}
+
+func TestFaultInjection(t *testing.T) {
+	require := require.New(t)
+
+	networkConfig := testNetworkConfig(t)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(context.Background(), networkConfig))
+	require.ErrorIs(net.PartitionNodes(context.Background(), [][]string{{"node1"}}), network.ErrFaultInjectionDisabled)
+	require.ErrorIs(net.HealPartitions(context.Background()), network.ErrFaultInjectionDisabled)
+	require.NoError(net.Stop(context.Background()))
+
+	networkConfig = testNetworkConfig(t)
+	networkConfig.FaultInjection = true
+	net, err = newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(context.Background(), networkConfig))
+	require.Len(net.faultProxy.listeners, len(networkConfig.NodeConfigs))
+
+	nodeNames, err := net.GetNodeNames()
+	require.NoError(err)
+	require.ErrorIs(net.PartitionNodes(context.Background(), [][]string{{"unknown"}}), network.ErrNodeNotFound)
+	require.NoError(net.PartitionNodes(context.Background(), [][]string{nodeNames[:1]}))
+	require.NoError(net.SetLinkLatency(context.Background(), nodeNames[0], nodeNames[1], time.Second, 0))
+	require.NoError(net.SetLinkLoss(context.Background(), nodeNames[1], nodeNames[0], 0.5))
+	require.ErrorIs(net.SetLinkLoss(context.Background(), nodeNames[1], "unknown", 0.5), network.ErrNodeNotFound)
+	require.NoError(net.HealPartitions(context.Background()))
+
+	// the proxy is closed on stop even for a node that isn't removed with it
+	delete(net.nodes, nodeNames[0])
+	require.NoError(net.Stop(context.Background()))
+	require.Empty(net.faultProxy.listeners)
+}
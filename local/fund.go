@@ -0,0 +1,201 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidFundAmount = errors.New("fund amount must be positive")
+	ErrDChainNonDIONE    = errors.New("only DIONE can be sent to the D-chain")
+)
+
+func (ln *localNetwork) Fund(
+	ctx context.Context,
+	fundSpec network.FundSpec,
+) (string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	return ln.fund(ctx, fundSpec)
+}
+
+// Sends [fundSpec.Amount] of the asset to the address, from the EWOQ key.
+// Assets other than DIONE are sent to the O-chain, and DIONE to the D-chain,
+// from the A-chain, with an export to the EWOQ key and an import to the
+// address. The D-chain import fee is paid with the imported DIONE.
+// Returns the ID of the last tx, once accepted.
+func (ln *localNetwork) fund(ctx context.Context, fundSpec network.FundSpec) (string, error) {
+	if fundSpec.Amount == 0 {
+		return "", ErrInvalidFundAmount
+	}
+	chainAlias, addr, err := ln.parseChainAddress(fundSpec.Address)
+	if err != nil {
+		return "", err
+	}
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return "", err
+	}
+	w, err := newWallet(ctx, clientURI, []ids.ID{})
+	if err != nil {
+		return "", err
+	}
+	dioneAssetID := w.aWallet.DIONEAssetID()
+	assetID := dioneAssetID
+	if fundSpec.AssetID != "" {
+		assetID, err = ids.FromString(fundSpec.AssetID)
+		if err != nil {
+			return "", fmt.Errorf("invalid asset ID %q: %w", fundSpec.AssetID, err)
+		}
+	}
+	if err := checkFundAsset(chainAlias, assetID, dioneAssetID); err != nil {
+		return "", err
+	}
+	ln.log.Info("funding address",
+		zap.String("address", fundSpec.Address),
+		zap.Stringer("asset-id", assetID),
+		zap.Uint64("amount", fundSpec.Amount),
+	)
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+	outputs := []*dione.TransferableOutput{
+		{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          fundSpec.Amount,
+				OutputOwners: *owner,
+			},
+		},
+	}
+
+	ewoqOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{w.addr},
+	}
+
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	switch chainAlias {
+	case "D":
+		exportTxID, err := exportAChainToDChain(ctx, w, ewoqOwner, fundSpec.Amount)
+		if err != nil {
+			return "", err
+		}
+		ln.log.Info("exported DIONE to D-Chain", zap.Stringer("tx-id", exportTxID))
+		importTxID, err := importDChainFromAChain(ctx, w, ethcommon.Address(addr))
+		if err != nil {
+			return "", err
+		}
+		return importTxID.String(), nil
+	case "O":
+		if assetID == dioneAssetID {
+			tx, err := w.oWallet.IssueBaseTx(outputs, common.WithContext(cctx), defaultPoll)
+			if err != nil {
+				return "", err
+			}
+			return tx.ID().String(), nil
+		}
+		exportTxID, err := exportAChainToOChain(ctx, w, ewoqOwner, assetID, fundSpec.Amount)
+		if err != nil {
+			return "", err
+		}
+		ln.log.Info("exported asset to O-Chain", zap.Stringer("tx-id", exportTxID))
+		importTxID, err := importOChainFromAChain(ctx, w, owner)
+		if err != nil {
+			return "", err
+		}
+		return importTxID.String(), nil
+	default:
+		tx, err := w.aWallet.IssueBaseTx(outputs, common.WithContext(cctx), defaultPoll)
+		if err != nil {
+			return "", err
+		}
+		return tx.ID().String(), nil
+	}
+}
+
+// The D-chain wallet only imports DIONE, so other assets can't be sent to the
+// D-chain.
+func checkFundAsset(chainAlias string, assetID ids.ID, dioneAssetID ids.ID) error {
+	if chainAlias == "D" && assetID != dioneAssetID {
+		return ErrDChainNonDIONE
+	}
+	return nil
+}
+
+func exportAChainToDChain(ctx context.Context, w *wallet, owner *secp256k1fx.OutputOwners, amount uint64) (ids.ID, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	tx, err := w.aWallet.IssueExportTx(
+		w.dWallet.BlockchainID(),
+		[]*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{
+					ID: w.aWallet.DIONEAssetID(),
+				},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          amount,
+					OutputOwners: *owner,
+				},
+			},
+		},
+		common.WithContext(cctx),
+		defaultPoll,
+	)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+func importDChainFromAChain(ctx context.Context, w *wallet, to ethcommon.Address) (ids.ID, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	tx, err := w.dWallet.IssueImportTx(
+		w.aWallet.BlockchainID(),
+		to,
+		common.WithContext(cctx),
+		defaultPoll,
+	)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+// Returns the chain alias, "A", "O" or "D", and the address of [addr], a chain
+// prefixed bech32 address of the network, or a hex address on the D-chain.
+func (ln *localNetwork) parseChainAddress(addr string) (string, ids.ShortID, error) {
+	if ethcommon.IsHexAddress(addr) {
+		return "D", ids.ShortID(ethcommon.HexToAddress(addr)), nil
+	}
+	chainAlias, hrp, addrBytes, err := address.Parse(addr)
+	if err != nil {
+		return "", ids.ShortID{}, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	if chainAlias != "A" && chainAlias != "O" {
+		return "", ids.ShortID{}, fmt.Errorf("address %q is not on the A- or O-chain", addr)
+	}
+	if expectedHRP := constants.GetHRP(ln.networkID); hrp != expectedHRP {
+		return "", ids.ShortID{}, fmt.Errorf("address %q has hrp %q, expected %q", addr, hrp, expectedHRP)
+	}
+	shortAddr, err := ids.ToShortID(addrBytes)
+	if err != nil {
+		return "", ids.ShortID{}, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	return chainAlias, shortAddr, nil
+}
@@ -0,0 +1,104 @@
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChainAddress(t *testing.T) {
+	ln := &localNetwork{log: logging.NoLog{}, networkID: constants.LocalID}
+	addr := genesis.EWOQKey.Address()
+	aChainAddr, err := address.Format("A", constants.GetHRP(constants.LocalID), addr[:])
+	require.NoError(t, err)
+	oChainAddr, err := address.Format("O", constants.GetHRP(constants.LocalID), addr[:])
+	require.NoError(t, err)
+	dChainAddr, err := address.Format("D", constants.GetHRP(constants.LocalID), addr[:])
+	require.NoError(t, err)
+	otherNetworkAddr, err := address.Format("A", constants.GetHRP(constants.TestnetID), addr[:])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		addr          string
+		expectedChain string
+		expectedAddr  ids.ShortID
+		expectedErr   string
+	}{
+		{
+			name:          "A-chain",
+			addr:          aChainAddr,
+			expectedChain: "A",
+			expectedAddr:  addr,
+		},
+		{
+			name:          "O-chain",
+			addr:          oChainAddr,
+			expectedChain: "O",
+			expectedAddr:  addr,
+		},
+		{
+			name:          "D-chain",
+			addr:          "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC",
+			expectedChain: "D",
+			expectedAddr:  NewAccount(genesis.EWOQKey).EthAddr,
+		},
+		{
+			name:        "D-chain bech32",
+			addr:        dChainAddr,
+			expectedErr: "is not on the A- or O-chain",
+		},
+		{
+			name:        "other network",
+			addr:        otherNetworkAddr,
+			expectedErr: "has hrp",
+		},
+		{
+			name:        "without chain",
+			addr:        aChainAddr[2:],
+			expectedErr: "invalid address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			chain, addr, err := ln.parseChainAddress(tt.addr)
+			if tt.expectedErr != "" {
+				require.ErrorContains(err, tt.expectedErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedChain, chain)
+			require.Equal(tt.expectedAddr, addr)
+		})
+	}
+}
+
+func TestFundInvalidSpec(t *testing.T) {
+	ln := &localNetwork{log: logging.NoLog{}, networkID: constants.LocalID}
+
+	_, err := ln.Fund(context.Background(), network.FundSpec{Address: "0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"})
+	require.ErrorIs(t, err, ErrInvalidFundAmount)
+	_, err = ln.Fund(context.Background(), network.FundSpec{Address: "invalid", Amount: 1})
+	require.ErrorContains(t, err, "invalid address")
+}
+
+func TestCheckFundAsset(t *testing.T) {
+	dioneAssetID := ids.GenerateTestID()
+	otherAssetID := ids.GenerateTestID()
+
+	for _, chain := range []string{"A", "O", "D"} {
+		require.NoError(t, checkFundAsset(chain, dioneAssetID, dioneAssetID))
+	}
+	require.NoError(t, checkFundAsset("A", otherAssetID, dioneAssetID))
+	require.NoError(t, checkFundAsset("O", otherAssetID, dioneAssetID))
+	require.ErrorIs(t, checkFundAsset("D", otherAssetID, dioneAssetID), ErrDChainNonDIONE)
+}
@@ -17,6 +17,50 @@ type NodeProcess struct {
 	mock.Mock
 }
 
+// Done provides a mock function with given fields:
+func (_m *NodeProcess) Done() <-chan struct{} {
+	ret := _m.Called()
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// ExitCode provides a mock function with given fields:
+func (_m *NodeProcess) ExitCode() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Pid provides a mock function with given fields:
+func (_m *NodeProcess) Pid() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // Status provides a mock function with given fields:
 func (_m *NodeProcess) Status() status.Status {
 	ret := _m.Called()
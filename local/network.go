@@ -23,7 +23,6 @@ import (
 	"github.com/DioneProtocol/odyssey-network-runner/network/node"
 	"github.com/DioneProtocol/odyssey-network-runner/network/node/status"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
-	"github.com/DioneProtocol/odyssey-network-runner/utils/constants"
 	"github.com/DioneProtocol/odysseygo/config"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/network/peer"
@@ -50,11 +49,15 @@ const (
 	genesisFileName           = "genesis.json"
 	stopTimeout               = 30 * time.Second
 	healthCheckFreq           = 3 * time.Second
-	DefaultNumNodes           = 5
-	snapshotPrefix            = "onr-snapshot-"
-	networkRootDirPrefix      = "network"
-	defaultDBSubdir           = "db"
-	defaultLogsSubdir         = "logs"
+	// Max time to wait for the nodes loaded from a snapshot to be healthy,
+	// before pausing some of them
+	loadHealthyTimeout   = 3 * time.Minute
+	DefaultNumNodes      = 5
+	snapshotPrefix       = "onr-snapshot-"
+	networkRootDirPrefix = "network"
+	nodeEventsBufferSize = 256
+	defaultDBSubdir      = "db"
+	defaultLogsSubdir    = "logs"
 	// difference between unlock schedule locktime and startime in original genesis
 	genesisLocktimeStartimeDelta = 2836800
 )
@@ -104,6 +107,8 @@ type localNetwork struct {
 	rootDir string
 	// directory where networks can be persistently saved
 	snapshotsDir string
+	// catalog of the saved snapshots, that uses [snapshotsDir] as local copy
+	snapshotStore SnapshotStore
 	// flags to apply to all nodes per default
 	flags map[string]interface{}
 	// binary path to use per default
@@ -118,6 +123,10 @@ type localNetwork struct {
 	reassignPortsIfUsed bool
 	// map from subnet id to elastic subnet tx id
 	subnetID2ElasticSubnetID map[ids.ID]ids.ID
+	// proxy in front of the nodes p2p ports. Nil if fault injection is disabled
+	faultProxy *faultProxy
+	// node events not requested through the network, such as crashes
+	nodeEventsCh chan network.NodeEvent
 }
 
 type deprecatedFlagEsp struct {
@@ -260,11 +269,13 @@ func init() {
 // If there isn't a directory at [dir] one will be created.
 // If len([dir]) == 0, files will be written underneath a new temporary directory.
 // Snapshots are saved to snapshotsDir, defaults to defaultSnapshotsDir if not given
+// Snapshots are kept in snapshotStore, defaults to snapshotsDir itself if nil
 func NewNetwork(
 	log logging.Logger,
 	networkConfig network.Config,
 	rootDir string,
 	snapshotsDir string,
+	snapshotStore SnapshotStore,
 	reassignPortsIfUsed bool,
 ) (network.Network, error) {
 	net, err := newNetwork(
@@ -278,6 +289,7 @@ func NewNetwork(
 		},
 		rootDir,
 		snapshotsDir,
+		snapshotStore,
 		reassignPortsIfUsed,
 	)
 	if err != nil {
@@ -295,17 +307,12 @@ func newNetwork(
 	nodeProcessCreator NodeProcessCreator,
 	rootDir string,
 	snapshotsDir string,
+	snapshotStore SnapshotStore,
 	reassignPortsIfUsed bool,
 ) (*localNetwork, error) {
 	var err error
 	if rootDir == "" {
-		onrRootDir := filepath.Join(os.TempDir(), constants.RootDirPrefix)
-		err = os.MkdirAll(onrRootDir, os.ModePerm)
-		if err != nil {
-			return nil, err
-		}
-		networkRootDir := filepath.Join(onrRootDir, networkRootDirPrefix)
-		rootDir, err = utils.MkDirWithTimestamp(networkRootDir)
+		rootDir, err = NewNetworkRootDir("", networkRootDirPrefix)
 		if err != nil {
 			return nil, err
 		}
@@ -318,6 +325,9 @@ func newNetwork(
 	if err != nil {
 		return nil, err
 	}
+	if snapshotStore == nil {
+		snapshotStore = NewFilesystemSnapshotStore(log, snapshotsDir)
+	}
 	// Create the network
 	net := &localNetwork{
 		nextNodeSuffix:           1,
@@ -329,8 +339,10 @@ func newNetwork(
 		nodeProcessCreator:       nodeProcessCreator,
 		rootDir:                  rootDir,
 		snapshotsDir:             snapshotsDir,
+		snapshotStore:            snapshotStore,
 		reassignPortsIfUsed:      reassignPortsIfUsed,
 		subnetID2ElasticSubnetID: map[ids.ID]ids.ID{},
+		nodeEventsCh:             make(chan network.NodeEvent, nodeEventsBufferSize),
 	}
 	return net, nil
 }
@@ -360,7 +372,7 @@ func NewDefaultNetwork(
 	reassignPortsIfUsed bool,
 ) (network.Network, error) {
 	config := NewDefaultConfig(binaryPath)
-	return NewNetwork(log, config, "", "", reassignPortsIfUsed)
+	return NewNetwork(log, config, "", "", nil, reassignPortsIfUsed)
 }
 
 // NewDefaultConfig creates a new default network config
@@ -381,6 +393,31 @@ func NewDefaultConfig(binaryPath string) network.Config {
 
 // NewDefaultConfigNNodes creates a new default network config, with an arbitrary number of nodes
 func NewDefaultConfigNNodes(binaryPath string, numNodes uint32) (network.Config, error) {
+	return newDefaultConfigNNodes(binaryPath, numNodes, func(uint32) (*NodeKeys, error) {
+		stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
+		}
+		return &NodeKeys{StakingKey: stakingKey, StakingCert: stakingCert}, nil
+	})
+}
+
+// NewDefaultConfigNNodesFromSeed is NewDefaultConfigNNodes, with the staking
+// identities of the nodes beyond the default ones given by [seed], as done by
+// SetNodeKeysFromSeed.
+func NewDefaultConfigNNodesFromSeed(binaryPath string, numNodes uint32, seed int64) (network.Config, error) {
+	return newDefaultConfigNNodes(binaryPath, numNodes, func(index uint32) (*NodeKeys, error) {
+		return NewNodeKeysFromSeed(seed, index)
+	})
+}
+
+// Creates a default network config with [numNodes] nodes, where the nodes
+// beyond the default ones get the keys given by [newNodeKeys] for their index.
+func newDefaultConfigNNodes(
+	binaryPath string,
+	numNodes uint32,
+	newNodeKeys func(index uint32) (*NodeKeys, error),
+) (network.Config, error) {
 	netConfig := NewDefaultConfig(binaryPath)
 	if int(numNodes) > len(netConfig.NodeConfigs) {
 		toAdd := int(numNodes) - len(netConfig.NodeConfigs)
@@ -403,12 +440,17 @@ func NewDefaultConfigNNodes(binaryPath string, numNodes uint32) (network.Config,
 		}
 		for i := 0; i < toAdd; i++ {
 			nodeConfig := refNodeConfig
-			stakingCert, stakingKey, err := staking.NewCertAndKeyBytes()
+			keys, err := newNodeKeys(uint32(len(netConfig.NodeConfigs)))
 			if err != nil {
-				return netConfig, fmt.Errorf("couldn't generate staking Cert/Key: %w", err)
+				return netConfig, err
+			}
+			nodeConfig.StakingKey = string(keys.StakingKey)
+			nodeConfig.StakingCert = string(keys.StakingCert)
+			// generated on node creation if empty
+			nodeConfig.StakingSigningKey = ""
+			if len(keys.SignerKey) > 0 {
+				nodeConfig.StakingSigningKey = base64.StdEncoding.EncodeToString(keys.SignerKey)
 			}
-			nodeConfig.StakingKey = string(stakingKey)
-			nodeConfig.StakingCert = string(stakingCert)
 			// replace ports
 			nodeConfig.Flags = map[string]interface{}{
 				config.HTTPPortKey:    int(refAPIPort) + (i+1)*2,
@@ -452,6 +494,12 @@ func (ln *localNetwork) loadConfig(ctx context.Context, networkConfig network.Co
 	if ln.subnetConfigFiles == nil {
 		ln.subnetConfigFiles = map[string]string{}
 	}
+	if networkConfig.FaultInjection {
+		if err := checkFaultProxySupport(); err != nil {
+			return err
+		}
+		ln.faultProxy = newFaultProxy(ln.log)
+	}
 
 	// Sort node configs so beacons start first
 	var nodeConfigs []node.Config
@@ -488,6 +536,12 @@ func (ln *localNetwork) AddNode(nodeConfig node.Config) (node.Node, error) {
 		return nil, network.ErrStopped
 	}
 
+	if nodeConfig.CloneDBFrom != "" {
+		if err := ln.cloneNodeDB(context.Background(), &nodeConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	return ln.addNode(nodeConfig)
 }
 
@@ -554,6 +608,10 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		return nil, err
 	}
 
+	if err := nodeConfig.RestartPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
 	isPausedNode := ln.isPausedNode(&nodeConfig)
 
 	nodeDir, err := makeNodeDir(ln.log, ln.rootDir, nodeConfig.Name)
@@ -586,14 +644,27 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 		return nil, fmt.Errorf("couldn't get node ID: %w", err)
 	}
 
+	// Put the proxy in front of the node before any peer tries to connect
+	if ln.faultProxy != nil {
+		if err := ln.faultProxy.addNode(nodeConfig.Name, nodeData.p2pPort); err != nil {
+			return nil, err
+		}
+	}
+
 	// Start the OdysseyGo node and pass it the flags defined above
-	nodeProcess, err := ln.nodeProcessCreator.NewNodeProcess(nodeConfig, nodeData.args...)
+	nodeProcess, err := newSupervisedProcess(ln.log, ln.nodeProcessCreator, ln.publishNodeEvent, nodeConfig, nodeData.args...)
 	if err != nil {
+		if ln.faultProxy != nil {
+			ln.faultProxy.removeNode(nodeConfig.Name)
+		}
 		return nil, fmt.Errorf(
 			"couldn't create new node process with binary %q and args %v: %w",
 			nodeConfig.BinaryPath, nodeData.args, err,
 		)
 	}
+	if ln.faultProxy != nil {
+		ln.faultProxy.setNodeProcess(nodeConfig.Name, nodeProcess)
+	}
 
 	ln.log.Info(
 		"adding node",
@@ -635,8 +706,12 @@ func (ln *localNetwork) addNode(nodeConfig node.Config) (node.Node, error) {
 	// Note that we do this *after* we set this node's bootstrap IPs/IDs
 	// so this node won't try to use itself as a beacon.
 	if !isPausedNode && nodeConfig.IsBeacon {
+		beaconIP := net.IPv6loopback
+		if ln.faultProxy != nil {
+			beaconIP = net.ParseIP(faultProxyHost)
+		}
 		err = ln.bootstraps.Add(beacon.New(nodeID, ips.IPPort{
-			IP:   net.IPv6loopback,
+			IP:   beaconIP,
 			Port: nodeData.p2pPort,
 		}))
 	}
@@ -777,6 +852,10 @@ func (ln *localNetwork) stop(ctx context.Context) error {
 		}
 		stopCtxCancel()
 	}
+	// don't rely on every node being removed cleanly to release the proxy ports
+	if ln.faultProxy != nil {
+		ln.faultProxy.close()
+	}
 	ln.log.Info("done stopping network")
 	return errs.Err
 }
@@ -805,6 +884,9 @@ func (ln *localNetwork) removeNode(ctx context.Context, nodeName string) error {
 	// If the node wasn't a beacon, we don't care
 	_ = ln.bootstraps.RemoveByID(node.nodeID)
 	delete(ln.nodes, nodeName)
+	if ln.faultProxy != nil {
+		ln.faultProxy.removeNode(nodeName)
+	}
 
 	if !paused {
 		// dchain eth api uses a websocket connection and must be closed before stopping the node,
@@ -817,6 +899,20 @@ func (ln *localNetwork) removeNode(ctx context.Context, nodeName string) error {
 	return nil
 }
 
+// See network.Network
+func (ln *localNetwork) NodeEvents() <-chan network.NodeEvent {
+	return ln.nodeEventsCh
+}
+
+// Does not block, so node events are dropped if [ln.nodeEventsCh] is full.
+func (ln *localNetwork) publishNodeEvent(event network.NodeEvent) {
+	select {
+	case ln.nodeEventsCh <- event:
+	default:
+		ln.log.Debug("dropping node event", zap.String("node", event.NodeName), zap.String("type", string(event.Type)))
+	}
+}
+
 // Sends a SIGTERM to the given node and keeps it in the network with paused state
 func (ln *localNetwork) PauseNode(ctx context.Context, nodeName string) error {
 	ln.lock.Lock()
@@ -843,6 +939,10 @@ func (ln *localNetwork) pauseNode(ctx context.Context, nodeName string) error {
 	if exitCode := node.process.Stop(ctx); exitCode != 0 {
 		return fmt.Errorf("node %q exited with exit code: %d", nodeName, exitCode)
 	}
+	// release the p2p port so the node can be resumed with it
+	if ln.faultProxy != nil {
+		ln.faultProxy.removeNode(nodeName)
+	}
 	syscall.Sync()
 	node.paused = true
 	return nil
@@ -1090,6 +1190,11 @@ func (ln *localNetwork) buildArgs(
 		config.BootstrapIPsKey: ln.bootstraps.IPsArg(),
 		config.BootstrapIDsKey: ln.bootstraps.IDsArg(),
 	}
+	// Listen on a host of its own, and advertise the proxy address
+	if ln.faultProxy != nil {
+		flags[config.StakingHostKey] = faultProxyNodeHost
+		flags[config.PublicIPKey] = faultProxyHost
+	}
 
 	// Write staking key/cert etc. to disk so the new node can use them,
 	// and get flag that point the node to those files
@@ -1150,12 +1255,28 @@ func (ln *localNetwork) getNodeSemVer(nodeConfig node.Config) (string, error) {
 			nodeConfig.BinaryPath, err,
 		)
 	}
+	return parseNodeSemVer(nodeVersionOutput, nodeConfig.BinaryPath)
+}
+
+// Returns the DB version in the --version output of an OdysseyGo binary, or
+// an empty string if not given.
+func parseNodeDBVersion(nodeVersionOutput string) string {
+	re := regexp.MustCompile(`database=([^,\]]+)`)
+	matches := re.FindStringSubmatch(nodeVersionOutput)
+	if len(matches) != 2 {
+		return ""
+	}
+	return matches[1]
+}
+
+// Returns the OdysseyGo version in the --version output of [binaryPath]
+func parseNodeSemVer(nodeVersionOutput string, binaryPath string) (string, error) {
 	re := regexp.MustCompile(`\/([^ ]+)`)
 	matches := re.FindStringSubmatch(nodeVersionOutput)
 	if len(matches) != 2 {
 		return "", fmt.Errorf(
 			"invalid version output %q for binary %q: version pattern not found",
-			nodeVersionOutput, nodeConfig.BinaryPath,
+			nodeVersionOutput, binaryPath,
 		)
 	}
 	nodeSemVer := "v" + matches[1]
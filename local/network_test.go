@@ -10,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,12 +23,15 @@ import (
 	"github.com/DioneProtocol/odyssey-network-runner/network/node/status"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
 	"github.com/DioneProtocol/odysseygo/api/health"
+	"github.com/DioneProtocol/odysseygo/api/info"
 	"github.com/DioneProtocol/odysseygo/config"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/message"
 	"github.com/DioneProtocol/odysseygo/snow/networking/router"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -107,6 +111,7 @@ func newMockAPISuccessful(string, uint16) api.Client {
 	client := &apimocks.Client{}
 	client.On("HealthAPI").Return(healthClient)
 	client.On("DChainEthAPI").Return(ethClient)
+	client.On("InfoAPI").Return(&fakeInfoClient{})
 	return client
 }
 
@@ -127,12 +132,43 @@ func newMockProcessUndef(node.Config, ...string) (NodeProcess, error) {
 // Returns a NodeProcess that always returns nil
 func newMockProcessSuccessful(node.Config, ...string) (NodeProcess, error) {
 	process := &mocks.NodeProcess{}
+	doneCh := make(chan struct{})
+	var stopOnce sync.Once
 	process.On("Wait").Return(nil)
-	process.On("Stop", mock.Anything).Return(0)
+	process.On("Stop", mock.Anything).Run(func(mock.Arguments) {
+		stopOnce.Do(func() { close(doneCh) })
+	}).Return(0)
 	process.On("Status").Return(status.Running)
+	process.On("Done").Return((<-chan struct{})(doneCh))
+	process.On("ExitCode").Return(0)
+	process.On("Pid").Return(0)
 	return process, nil
 }
 
+// fakeOChainClient implements the O-Chain API calls used to describe snapshots
+type fakeOChainClient struct {
+	omegavm.Client
+	subnets     []omegavm.ClientSubnet
+	blockchains []omegavm.APIBlockchain
+}
+
+func (c *fakeOChainClient) GetSubnets(context.Context, []ids.ID, ...rpc.Option) ([]omegavm.ClientSubnet, error) {
+	return c.subnets, nil
+}
+
+func (c *fakeOChainClient) GetBlockchains(context.Context, ...rpc.Option) ([]omegavm.APIBlockchain, error) {
+	return c.blockchains, nil
+}
+
+// fakeInfoClient implements the Info API calls used to describe snapshots
+type fakeInfoClient struct {
+	info.Client
+}
+
+func (*fakeInfoClient) GetNodeVersion(context.Context, ...rpc.Option) (*info.GetNodeVersionReply, error) {
+	return &info.GetNodeVersionReply{VMVersions: map[string]string{"omegavm": "v1.9.5"}}, nil
+}
+
 type noOpInboundHandler struct{}
 
 func (*noOpInboundHandler) HandleInbound(context.Context, message.InboundMessage) {}
@@ -149,6 +185,7 @@ func TestNewNetworkEmpty(t *testing.T) {
 		&localTestProcessUndefNodeProcessCreator{},
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -212,6 +249,7 @@ func TestNewNetworkOneNode(t *testing.T) {
 		creator,
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -240,6 +278,7 @@ func TestNewNetworkFailToStartNode(t *testing.T) {
 		&localTestFailedStartProcessCreator{},
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -477,7 +516,7 @@ func TestWrongNetworkConfigs(t *testing.T) {
 	require := require.New(t)
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+			net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 			require.NoError(err)
 			err = net.loadConfig(context.Background(), tt.config)
 			require.Error(err)
@@ -491,7 +530,7 @@ func TestUnhealthyNetwork(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, newMockAPIUnhealthy, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPIUnhealthy, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
@@ -506,7 +545,7 @@ func TestGeneratedNodesNames(t *testing.T) {
 	for i := range networkConfig.NodeConfigs {
 		networkConfig.NodeConfigs[i].Name = ""
 	}
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
@@ -526,7 +565,7 @@ func TestGenerateDefaultNetwork(t *testing.T) {
 	require := require.New(t)
 	binaryPath := "pepito"
 	networkConfig := NewDefaultConfig(binaryPath)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
@@ -576,7 +615,7 @@ func TestNetworkFromConfig(t *testing.T) {
 	t.Parallel()
 	require := require.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
@@ -600,7 +639,7 @@ func TestNetworkNodeOps(t *testing.T) {
 	// Start a new, empty network
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	require.NoError(err)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), emptyNetworkConfig)
 	require.NoError(err)
@@ -630,6 +669,95 @@ func TestNetworkNodeOps(t *testing.T) {
 	}
 }
 
+func TestSaveSnapshotAndContinue(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	elasticSubnetID := ids.GenerateTestID()
+	vmID, err := utils.VMID("subnetevm")
+	require.NoError(err)
+	blockchain := omegavm.APIBlockchain{ID: ids.GenerateTestID(), Name: "evm", SubnetID: subnetID, VMID: vmID}
+	oChainClient := &fakeOChainClient{
+		subnets: []omegavm.ClientSubnet{{ID: constants.PrimaryNetworkID}, {ID: subnetID}},
+		blockchains: []omegavm.APIBlockchain{
+			{ID: ids.GenerateTestID(), Name: "D-Chain", SubnetID: constants.PrimaryNetworkID},
+			blockchain,
+		},
+	}
+	newAPIClient := func(host string, port uint16) api.Client {
+		client := newMockAPISuccessful(host, port).(*apimocks.Client)
+		client.On("OChainAPI").Return(oChainClient)
+		return client
+	}
+
+	snapshotsDir := t.TempDir()
+	net, err := newNetwork(logging.NoLog{}, newAPIClient, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(context.Background(), testNetworkConfig(t)))
+	require.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+	require.NoError(net.PauseNode(context.Background(), "node2"))
+
+	ports := map[string][2]uint16{}
+	for nodeName, node := range net.nodes {
+		ports[nodeName] = [2]uint16{node.GetAPIPort(), node.GetP2PPort()}
+		dbDir := filepath.Join(node.GetDbDir(), constants.NetworkName(net.networkID))
+		require.NoError(os.MkdirAll(dbDir, os.ModePerm))
+		require.NoError(os.WriteFile(filepath.Join(dbDir, "db"), []byte(nodeName), 0o600))
+	}
+	net.subnetID2ElasticSubnetID[subnetID] = elasticSubnetID
+
+	labels := map[string]string{"fixture": "elastic"}
+	snapshotDir, err := net.SaveSnapshotAndContinue(context.Background(), "snapshot", "elastic subnet", labels)
+	require.NoError(err)
+	for nodeName := range ports {
+		data, err := os.ReadFile(filepath.Join(snapshotDir, defaultDBSubdir, nodeName, constants.NetworkName(net.networkID), "db"))
+		require.NoError(err)
+		require.Equal(nodeName, string(data))
+	}
+	require.FileExists(filepath.Join(snapshotDir, "network.json"))
+	require.FileExists(filepath.Join(snapshotDir, "state.json"))
+
+	// the nodes run again with the same ports, and paused nodes stay paused
+	require.False(net.stopCalled())
+	require.Len(net.nodes, len(ports))
+	for nodeName, node := range net.nodes {
+		require.Equal(nodeName == "node2", node.GetPaused())
+		require.Equal(ports[nodeName], [2]uint16{node.GetAPIPort(), node.GetP2PPort()})
+	}
+	require.Len(net.subnetID2ElasticSubnetID, 1)
+
+	metadata, err := GetSnapshotMetadata(snapshotsDir, "snapshot")
+	require.NoError(err)
+	require.Equal("snapshot", metadata.SnapshotName)
+	require.Equal("elastic subnet", metadata.Description)
+	require.Equal(labels, metadata.Labels)
+	require.Equal("v1.9.5", metadata.OdysseyGoVersion)
+	require.Equal(len(ports), metadata.NumNodes)
+	require.Equal(int64(len("node0")*len(ports)), metadata.DBSize)
+	require.Equal([]SnapshotSubnet{{
+		ID:              subnetID.String(),
+		ElasticSubnetID: elasticSubnetID.String(),
+		Blockchains: []SnapshotBlockchain{{
+			ID:     blockchain.ID.String(),
+			Name:   "evm",
+			VMID:   vmID.String(),
+			VMName: "subnetevm",
+		}},
+	}}, metadata.Subnets)
+
+	snapshots, err := ListSnapshotMetadata(context.Background(), net.snapshotStore, map[string]string{"fixture": "other"})
+	require.NoError(err)
+	require.Empty(snapshots)
+	snapshots, err = ListSnapshotMetadata(context.Background(), net.snapshotStore, labels)
+	require.NoError(err)
+	require.Len(snapshots, 1)
+
+	_, err = net.SaveSnapshotAndContinue(context.Background(), "snapshot", "", nil)
+	require.Error(err)
+	require.NoError(net.Stop(context.Background()))
+}
+
 // TestNodeNotFound checks all operations fail for an unknown node,
 // being it either not created, or created and removed thereafter
 func TestNodeNotFound(t *testing.T) {
@@ -638,7 +766,7 @@ func TestNodeNotFound(t *testing.T) {
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	require.NoError(err)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), emptyNetworkConfig)
 	require.NoError(err)
@@ -671,7 +799,7 @@ func TestStoppedNetwork(t *testing.T) {
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	require.NoError(err)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), emptyNetworkConfig)
 	require.NoError(err)
@@ -704,7 +832,7 @@ func TestStoppedNetwork(t *testing.T) {
 func TestGetAllNodes(t *testing.T) {
 	require := require.New(t)
 	networkConfig := testNetworkConfig(t)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
@@ -755,6 +883,7 @@ func TestFlags(t *testing.T) {
 		},
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -784,6 +913,7 @@ func TestFlags(t *testing.T) {
 		},
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -812,6 +942,7 @@ func TestFlags(t *testing.T) {
 		},
 		"",
 		"",
+		nil,
 		false,
 	)
 	require.NoError(err)
@@ -1273,7 +1404,7 @@ func TestRemoveBeacon(t *testing.T) {
 	// create a network with no nodes in it
 	emptyNetworkConfig, err := emptyNetworkConfig()
 	require.NoError(err)
-	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), emptyNetworkConfig)
 	require.NoError(err)
@@ -1324,7 +1455,7 @@ func TestHealthyDuringNetworkStop(t *testing.T) {
 	require := require.New(t)
 	networkConfig := testNetworkConfig(t)
 	// Calls to a node's Healthy() function blocks until context cancelled
-	net, err := newNetwork(logging.NoLog{}, newMockAPIHealthyBlocks, &localTestSuccessfulNodeProcessCreator{}, "", "", false)
+	net, err := newNetwork(logging.NoLog{}, newMockAPIHealthyBlocks, &localTestSuccessfulNodeProcessCreator{}, "", "", nil, false)
 	require.NoError(err)
 	err = net.loadConfig(context.Background(), networkConfig)
 	require.NoError(err)
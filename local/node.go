@@ -53,8 +53,9 @@ type localNode struct {
 	networkID uint32
 	// Allows user to make API calls to this node.
 	client api.Client
-	// The process running this node.
-	process NodeProcess
+	// The process running this node, relaunched as given by
+	// the node restart policy.
+	process *supervisedProcess
 	// The API port
 	apiPort uint16
 	// The P2P (staking) port
@@ -285,3 +286,18 @@ func (node *localNode) GetFlag(k string) (string, error) {
 func (node *localNode) GetPaused() bool {
 	return node.paused
 }
+
+// See node.Node
+func (node *localNode) GetRestartCount() uint32 {
+	return node.process.RestartCount()
+}
+
+// See node.Node
+func (node *localNode) GetLastExitCode() int {
+	return node.process.LastExitCode()
+}
+
+// See node.Node
+func (node *localNode) GetResourceUsage() []node.ResourceUsage {
+	return node.process.ResourceUsage()
+}
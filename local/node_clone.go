@@ -0,0 +1,70 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odysseygo/config"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+// Seeds the DB of the node to be added with [nodeConfig] with a copy of the
+// DB of the node [nodeConfig.CloneDBFrom]. The source node is paused while
+// its DB is copied, the same way as when saving a snapshot, and resumed
+// afterwards. Sets the name of the new node, if not given, and its DB path.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) cloneNodeDB(ctx context.Context, nodeConfig *node.Config) error {
+	sourceNodeName := nodeConfig.CloneDBFrom
+	nodeConfig.CloneDBFrom = ""
+	sourceNode, ok := ln.nodes[sourceNodeName]
+	if !ok {
+		return fmt.Errorf("node %q to clone the DB from not found", sourceNodeName)
+	}
+	// the DB dir is given by the name
+	if err := ln.setNodeName(nodeConfig); err != nil {
+		return err
+	}
+	if _, ok := ln.nodes[nodeConfig.Name]; ok {
+		return fmt.Errorf("repeated node name %q", nodeConfig.Name)
+	}
+
+	ln.log.Info("cloning node db", zap.String("source", sourceNodeName), zap.String("node-name", nodeConfig.Name))
+	wasRunning := !sourceNode.paused
+	if wasRunning {
+		pauseCtx, pauseCtxCancel := context.WithTimeout(ctx, stopTimeout)
+		err := ln.pauseNode(pauseCtx, sourceNodeName)
+		pauseCtxCancel()
+		if err != nil {
+			return fmt.Errorf("failure pausing node %q: %w", sourceNodeName, err)
+		}
+	}
+	// immutable files are shared with the source node
+	sourceDBDir := filepath.Join(sourceNode.GetDbDir(), constants.NetworkName(ln.networkID))
+	targetDBDir := filepath.Join(getNodeDir(ln.rootDir, nodeConfig.Name), defaultDBSubdir)
+	copier := newDBCopier(ln.log, "")
+	copyErr := copier.copyDir(sourceDBDir, filepath.Join(targetDBDir, constants.NetworkName(ln.networkID)))
+	if copyErr != nil {
+		_ = os.RemoveAll(targetDBDir)
+	}
+	// the source node is resumed even if the DB couldn't be copied
+	if wasRunning {
+		if err := ln.resumeNode(ctx, sourceNodeName); err != nil {
+			return fmt.Errorf("failure resuming node %q: %w", sourceNodeName, err)
+		}
+	}
+	if copyErr != nil {
+		return fmt.Errorf("failure cloning node %q db dir: %w", sourceNodeName, copyErr)
+	}
+
+	nodeConfig.Flags = maps.Clone(nodeConfig.Flags)
+	if nodeConfig.Flags == nil {
+		nodeConfig.Flags = map[string]interface{}{}
+	}
+	nodeConfig.Flags[config.DBPathKey] = targetDBDir
+	return nil
+}
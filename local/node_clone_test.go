@@ -0,0 +1,66 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNodeCloneDB(t *testing.T) {
+	require := require.New(t)
+
+	net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), t.TempDir(), nil, false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(context.Background(), testNetworkConfig(t)))
+	require.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+	require.NoError(net.PauseNode(context.Background(), "node2"))
+	for nodeName, node := range net.nodes {
+		dbDir := filepath.Join(node.GetDbDir(), constants.NetworkName(net.networkID))
+		require.NoError(os.MkdirAll(dbDir, os.ModePerm))
+		require.NoError(os.WriteFile(filepath.Join(dbDir, "000001.ldb"), []byte(nodeName), 0o600))
+	}
+	source, err := net.GetNode("node0")
+	require.NoError(err)
+	sourceConfig := source.GetConfig()
+
+	// running source node is resumed with the same ports
+	clone, err := net.AddNode(node.Config{Name: "clone0", CloneDBFrom: "node0"})
+	require.NoError(err)
+	require.NotEqual(source.GetNodeID(), clone.GetNodeID())
+	require.NotEqual(source.GetAPIPort(), clone.GetAPIPort())
+	require.Empty(clone.GetConfig().CloneDBFrom)
+	data, err := os.ReadFile(filepath.Join(clone.GetDbDir(), constants.NetworkName(net.networkID), "000001.ldb"))
+	require.NoError(err)
+	require.Equal("node0", string(data))
+	source, err = net.GetNode("node0")
+	require.NoError(err)
+	require.False(source.GetPaused())
+	require.Equal(sourceConfig.Flags, source.GetConfig().Flags)
+
+	// paused source node stays paused, and the name defaults
+	clone, err = net.AddNode(node.Config{CloneDBFrom: "node2"})
+	require.NoError(err)
+	data, err = os.ReadFile(filepath.Join(clone.GetDbDir(), constants.NetworkName(net.networkID), "000001.ldb"))
+	require.NoError(err)
+	require.Equal("node2", string(data))
+	source, err = net.GetNode("node2")
+	require.NoError(err)
+	require.True(source.GetPaused())
+
+	_, err = net.AddNode(node.Config{CloneDBFrom: "node9"})
+	require.ErrorContains(err, "not found")
+	_, err = net.AddNode(node.Config{Name: "node2", CloneDBFrom: "node0"})
+	require.ErrorContains(err, "repeated node name")
+	require.Len(net.nodes, 5)
+
+	stopCtx, stopCtxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer stopCtxCancel()
+	require.NoError(net.Stop(stopCtx))
+}
@@ -0,0 +1,208 @@
+package local
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/utils"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+)
+
+// Order of the BLS12-381 scalar field, BLS secret keys must be below it
+var blsOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// NodeKeys is the staking identity of a node.
+type NodeKeys struct {
+	// PEM encoded TLS staking key and cert
+	StakingKey  []byte
+	StakingCert []byte
+	// BLS signer key, as read by odysseygo from the signer key file
+	SignerKey []byte
+	NodeID    ids.NodeID
+}
+
+// NewNodeKeysFromSeed returns the staking identity of the node [index] of
+// the networks created with [seed]. The same seed and index always give the
+// same keys, and so the same node ID.
+// The staking key is an ECDSA P-256 key, as RSA key generation can't be made
+// deterministic.
+func NewNodeKeysFromSeed(seed int64, index uint32) (*NodeKeys, error) {
+	stakingKey, err := newSeededECDSAKey(seed, index)
+	if err != nil {
+		return nil, err
+	}
+	// the cert must be the same byte by byte, as the node ID is its hash
+	certTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2100, time.January, 0, 0, 0, 0, 0, time.UTC),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	signer := &deterministicECDSASigner{key: stakingKey}
+	certBytes, err := x509.CreateCertificate(nil, certTemplate, certTemplate, &stakingKey.PublicKey, signer)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create staking certificate: %w", err)
+	}
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(stakingKey)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal staking key: %w", err)
+	}
+	keys := &NodeKeys{
+		StakingKey:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}),
+		StakingCert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}),
+	}
+
+	signerKey, err := newSeededBLSKey(seed, index)
+	if err != nil {
+		return nil, err
+	}
+	keys.SignerKey = bls.SecretKeyToBytes(signerKey)
+
+	keys.NodeID, err = utils.ToNodeID(keys.StakingKey, keys.StakingCert)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// SetNodeKeysFromSeed sets the staking identity of the nodes of
+// [networkConfig], from the [fromIndex]-th on, to the one given by [seed]
+// and the node position.
+func SetNodeKeysFromSeed(networkConfig *network.Config, seed int64, fromIndex int) error {
+	for i := fromIndex; i < len(networkConfig.NodeConfigs); i++ {
+		keys, err := NewNodeKeysFromSeed(seed, uint32(i))
+		if err != nil {
+			return err
+		}
+		networkConfig.NodeConfigs[i].StakingKey = string(keys.StakingKey)
+		networkConfig.NodeConfigs[i].StakingCert = string(keys.StakingCert)
+		networkConfig.NodeConfigs[i].StakingSigningKey = base64.StdEncoding.EncodeToString(keys.SignerKey)
+	}
+	return nil
+}
+
+// Returns the [counter]-th hash of the key material of type [keyType] for
+// the node [index] of the networks created with [seed].
+func seededKeyMaterial(keyType string, seed int64, index uint32, counter uint32) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte(keyType))
+	_ = binary.Write(h, binary.BigEndian, seed)
+	_ = binary.Write(h, binary.BigEndian, index)
+	_ = binary.Write(h, binary.BigEndian, counter)
+	return h.Sum(nil)
+}
+
+func newSeededECDSAKey(seed int64, index uint32) (*ecdsa.PrivateKey, error) {
+	for counter := uint32(0); ; counter++ {
+		// fails if the scalar is zero or above the curve order
+		ecdhKey, err := ecdh.P256().NewPrivateKey(seededKeyMaterial("staking", seed, index, counter))
+		if err != nil {
+			continue
+		}
+		return ecdhToECDSAKey(ecdhKey)
+	}
+}
+
+func newSeededBLSKey(seed int64, index uint32) (*bls.SecretKey, error) {
+	for counter := uint32(0); ; counter++ {
+		scalar := new(big.Int).SetBytes(seededKeyMaterial("signer", seed, index, counter))
+		scalar.Mod(scalar, blsOrder)
+		if scalar.Sign() == 0 {
+			continue
+		}
+		return bls.SecretKeyFromBytes(scalar.FillBytes(make([]byte, 32)))
+	}
+}
+
+func ecdhToECDSAKey(ecdhKey *ecdh.PrivateKey) (*ecdsa.PrivateKey, error) {
+	// uncompressed point: 0x04 || X || Y
+	point := ecdhKey.PublicKey().Bytes()
+	if len(point) != 65 || point[0] != 4 {
+		return nil, fmt.Errorf("unexpected P-256 public key encoding")
+	}
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(ecdhKey.Bytes()),
+	}, nil
+}
+
+// deterministicECDSASigner signs with a nonce derived from the key and the
+// digest, so the same digest always gets the same signature.
+type deterministicECDSASigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *deterministicECDSASigner) Public() crypto.PublicKey {
+	return &s.key.PublicKey
+}
+
+func (s *deterministicECDSASigner) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	n := s.key.Curve.Params().N
+	e := new(big.Int).SetBytes(digest)
+	if len(digest) > 32 {
+		e.SetBytes(digest[:32])
+	}
+	mac := hmac.New(sha256.New, s.key.D.FillBytes(make([]byte, 32)))
+	for counter := uint32(0); ; counter++ {
+		mac.Reset()
+		_, _ = mac.Write(digest)
+		_ = binary.Write(mac, binary.BigEndian, counter)
+		nonce, err := ecdh.P256().NewPrivateKey(mac.Sum(nil))
+		if err != nil {
+			continue
+		}
+		point := nonce.PublicKey().Bytes()
+		r := new(big.Int).SetBytes(point[1:33])
+		r.Mod(r, n)
+		if r.Sign() == 0 {
+			continue
+		}
+		k := new(big.Int).SetBytes(nonce.Bytes())
+		sig := new(big.Int).Mul(r, s.key.D)
+		sig.Add(sig, e)
+		sig.Mul(sig, new(big.Int).ModInverse(k, n))
+		sig.Mod(sig, n)
+		if sig.Sign() == 0 {
+			continue
+		}
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{r, sig})
+	}
+}
+
+// WriteNodeKeys writes [keys] to [dir], with the file names of the
+// default node configs.
+func WriteNodeKeys(dir string, keys *NodeKeys) error {
+	for fileName, contents := range map[string][]byte{
+		stakingKeyFileName:        keys.StakingKey,
+		stakingCertFileName:       keys.StakingCert,
+		stakingSigningKeyFileName: keys.SignerKey,
+	} {
+		if err := createFileAndWrite(filepath.Join(dir, fileName), contents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package local
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/staking"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodeKeysFromSeed(t *testing.T) {
+	require := require.New(t)
+
+	keys, err := NewNodeKeysFromSeed(1, 0)
+	require.NoError(err)
+	sameKeys, err := NewNodeKeysFromSeed(1, 0)
+	require.NoError(err)
+	require.Equal(keys, sameKeys)
+
+	otherIndexKeys, err := NewNodeKeysFromSeed(1, 1)
+	require.NoError(err)
+	require.NotEqual(keys.NodeID, otherIndexKeys.NodeID)
+	require.NotEqual(keys.SignerKey, otherIndexKeys.SignerKey)
+	otherSeedKeys, err := NewNodeKeysFromSeed(2, 0)
+	require.NoError(err)
+	require.NotEqual(keys.NodeID, otherSeedKeys.NodeID)
+
+	// the cert is accepted by the nodes
+	block, _ := pem.Decode(keys.StakingCert)
+	require.NotNil(block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(err)
+	require.NoError(staking.ValidateCertificate(staking.CertificateFromX509(cert)))
+	require.NoError(cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature))
+	_, err = staking.LoadTLSCertFromBytes(keys.StakingKey, keys.StakingCert)
+	require.NoError(err)
+	_, err = bls.SecretKeyFromBytes(keys.SignerKey)
+	require.NoError(err)
+
+	dir := t.TempDir()
+	require.NoError(WriteNodeKeys(dir, keys))
+	for fileName, contents := range map[string][]byte{
+		stakingKeyFileName:        keys.StakingKey,
+		stakingCertFileName:       keys.StakingCert,
+		stakingSigningKeyFileName: keys.SignerKey,
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, fileName))
+		require.NoError(err)
+		require.Equal(contents, data)
+	}
+}
+
+func TestSetNodeKeysFromSeed(t *testing.T) {
+	require := require.New(t)
+
+	networkConfig, err := NewDefaultConfigNNodes("pepito", 6)
+	require.NoError(err)
+	defaultConfig := NewDefaultConfig("pepito")
+	require.NoError(SetNodeKeysFromSeed(&networkConfig, 1, DefaultNumNodes))
+	for i := 0; i < DefaultNumNodes; i++ {
+		require.Equal(defaultConfig.NodeConfigs[i].StakingCert, networkConfig.NodeConfigs[i].StakingCert)
+	}
+	keys, err := NewNodeKeysFromSeed(1, 5)
+	require.NoError(err)
+	require.Equal(string(keys.StakingCert), networkConfig.NodeConfigs[5].StakingCert)
+	require.NoError(networkConfig.Validate())
+}
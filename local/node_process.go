@@ -29,6 +29,12 @@ type NodeProcess interface {
 	Stop(ctx context.Context) int
 	// Returns the status of the process.
 	Status() status.Status
+	// Returns a channel that is closed when the process exits.
+	Done() <-chan struct{}
+	// Returns the exit code of the process, or -1 if it has not exited.
+	ExitCode() int
+	// Returns the ID of the process, or 0 if it is not running.
+	Pid() int
 }
 
 // NodeProcessCreator is an interface for new node process creation
@@ -187,6 +193,30 @@ func (p *nodeProcess) Status() status.Status {
 	return p.state
 }
 
+func (p *nodeProcess) Done() <-chan struct{} {
+	return p.closedOnStop
+}
+
+func (p *nodeProcess) ExitCode() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.state != status.Stopped || p.cmd.ProcessState == nil {
+		return -1
+	}
+	return p.cmd.ProcessState.ExitCode()
+}
+
+func (p *nodeProcess) Pid() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.state == status.Stopped {
+		return 0
+	}
+	return p.cmd.Process.Pid
+}
+
 func killDescendants(pid int32, log logging.Logger) {
 	procs, err := process.Processes()
 	if err != nil {
@@ -0,0 +1,22 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// Creates [dst] as a copy-on-write clone of [src].
+// Returns errReflinkUnsupported if the filesystem can't clone between them.
+func reflinkFile(src string, dst string, perm fs.FileMode) error {
+	if err := unix.Clonefile(src, dst, unix.CLONE_NOFOLLOW); err != nil {
+		if errors.Is(err, unix.ENOTSUP) || errors.Is(err, unix.EXDEV) {
+			return fmt.Errorf("%w: %s", errReflinkUnsupported, err)
+		}
+		return err
+	}
+	return os.Chmod(dst, perm)
+}
@@ -0,0 +1,38 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// Creates [dst] as a copy-on-write clone of [src].
+// Returns errReflinkUnsupported if the filesystem can't clone between them.
+func reflinkFile(src string, dst string, perm fs.FileMode) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+	dstFile, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	err = unix.IoctlFileClone(int(dstFile.Fd()), int(srcFile.Fd()))
+	closeErr := dstFile.Close()
+	if err != nil {
+		_ = os.Remove(dst)
+		if errors.Is(err, unix.EOPNOTSUPP) ||
+			errors.Is(err, unix.EXDEV) ||
+			errors.Is(err, unix.EINVAL) ||
+			errors.Is(err, unix.ENOTTY) ||
+			errors.Is(err, unix.ENOSYS) {
+			return fmt.Errorf("%w: %s", errReflinkUnsupported, err)
+		}
+		return err
+	}
+	return closeErr
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+
+package local
+
+import "io/fs"
+
+func reflinkFile(string, string, fs.FileMode) error {
+	return errReflinkUnsupported
+}
@@ -0,0 +1,104 @@
+package local
+
+import (
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/shirou/gopsutil/process"
+)
+
+const (
+	resourceSampleFreq = 10 * time.Second
+	// Number of samples kept, covering the last 5 minutes
+	resourceHistorySize = 30
+)
+
+// resourceMonitor keeps the last resource usage samples of a node
+// process and its descendants.
+type resourceMonitor struct {
+	lock    sync.RWMutex
+	history []node.ResourceUsage
+	// Process sampled last, and the CPU time used by its tree then
+	lastPid     int32
+	lastCPUTime float64
+}
+
+// Samples the usage of the process [pid] and its descendants.
+func (m *resourceMonitor) sample(pid int32) error {
+	usage, cpuTime, err := getProcessTreeUsage(pid)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(m.history) > 0 && m.lastPid == pid {
+		prev := m.history[len(m.history)-1]
+		elapsed := usage.Time.Sub(prev.Time).Seconds()
+		// descendants that exited take their CPU time with them
+		if elapsed > 0 && cpuTime > m.lastCPUTime {
+			usage.CPUPercent = 100 * (cpuTime - m.lastCPUTime) / elapsed
+		}
+	}
+	m.lastPid = pid
+	m.lastCPUTime = cpuTime
+	m.history = append(m.history, usage)
+	if len(m.history) > resourceHistorySize {
+		m.history = m.history[len(m.history)-resourceHistorySize:]
+	}
+	return nil
+}
+
+// Returns the kept samples, oldest first.
+func (m *resourceMonitor) History() []node.ResourceUsage {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return append([]node.ResourceUsage{}, m.history...)
+}
+
+// Returns the usage of the process [pid] and its descendants, and the
+// CPU time they used, in seconds. The CPU percentage is not set, as it
+// needs a previous sample.
+func getProcessTreeUsage(pid int32) (node.ResourceUsage, float64, error) {
+	root, err := process.NewProcess(pid)
+	if err != nil {
+		return node.ResourceUsage{}, 0, err
+	}
+	// walk down from the root, instead of scanning all the host processes
+	procs := []*process.Process{root}
+	for i := 0; i < len(procs); i++ {
+		// a process without children, or that exited, has none to add
+		if children, err := procs[i].Children(); err == nil {
+			procs = append(procs, children...)
+		}
+	}
+
+	usage := node.ResourceUsage{
+		Time:         time.Now(),
+		NumProcesses: int32(len(procs)),
+	}
+	cpuTime := 0.0
+	// processes may exit while being sampled, so errors are skipped
+	for _, proc := range procs {
+		if times, err := proc.Times(); err == nil {
+			cpuTime += times.User + times.System
+		}
+		if mem, err := proc.MemoryInfo(); err == nil {
+			usage.RSSBytes += mem.RSS
+		}
+		if numFDs, err := proc.NumFDs(); err == nil {
+			usage.NumFDs += numFDs
+		}
+		if numThreads, err := proc.NumThreads(); err == nil {
+			usage.NumThreads += numThreads
+		}
+		if io, err := proc.IOCounters(); err == nil {
+			usage.ReadBytes += io.ReadBytes
+			usage.WriteBytes += io.WriteBytes
+		}
+	}
+	return usage, cpuTime, nil
+}
@@ -0,0 +1,49 @@
+package local
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceMonitor(t *testing.T) {
+	require := require.New(t)
+
+	// a child process, to be counted with the test process
+	cmd := exec.Command("sleep", "30")
+	require.NoError(cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	m := resourceMonitor{}
+	pid := int32(os.Getpid())
+	require.NoError(m.sample(pid))
+	// spin to use some CPU time between the samples
+	for x := 0; x < 100_000_000; x++ {
+		_ = x * x
+	}
+	require.NoError(m.sample(pid))
+
+	history := m.History()
+	require.Len(history, 2)
+	usage := history[1]
+	require.True(usage.Time.After(history[0].Time))
+	require.Zero(history[0].CPUPercent)
+	require.Positive(usage.CPUPercent)
+	require.Positive(usage.RSSBytes)
+	require.Positive(usage.NumThreads)
+	require.Positive(usage.NumFDs)
+	require.GreaterOrEqual(usage.NumProcesses, int32(2))
+
+	// the history is bounded
+	for i := 0; i < resourceHistorySize; i++ {
+		require.NoError(m.sample(pid))
+	}
+	require.Len(m.History(), resourceHistorySize)
+
+	require.Error(m.sample(-1))
+}
@@ -0,0 +1,150 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/DioneProtocol/odyssey-network-runner/utils"
+	"github.com/DioneProtocol/odyssey-network-runner/utils/constants"
+	"github.com/DioneProtocol/odysseygo/config"
+)
+
+// Written in the network root dirs created by the runner, to tell which
+// process owns them
+const rootDirOwnerFileName = "owner.json"
+
+// Contents of the owner file of a network root dir, and of the process
+// context file written by the nodes in their data dirs
+type processContext struct {
+	PID int `json:"pid"`
+}
+
+// NewNetworkRootDir creates a dir named [prefix] plus a timestamp under
+// [parentDir], and records the current process as its owner, so that it can
+// be pruned once the process is gone.
+// [parentDir] defaults to the runner dir of the temp dir if empty.
+func NewNetworkRootDir(parentDir string, prefix string) (string, error) {
+	if parentDir == "" {
+		parentDir = filepath.Join(os.TempDir(), constants.RootDirPrefix)
+	}
+	if err := os.MkdirAll(parentDir, os.ModePerm); err != nil {
+		return "", err
+	}
+	rootDir, err := utils.MkDirWithTimestamp(filepath.Join(parentDir, prefix))
+	if err != nil {
+		return "", err
+	}
+	ownerJSON, err := json.Marshal(processContext{PID: os.Getpid()})
+	if err != nil {
+		return "", err
+	}
+	return rootDir, createFileAndWrite(filepath.Join(rootDir, rootDirOwnerFileName), ownerJSON)
+}
+
+// PruneNetworkRootDirs removes the network root dirs of [parentDir] whose
+// owner process is no longer running, and none of their nodes either.
+// Dirs without a recorded owner are pruned once none of their nodes is
+// running. If [dryRun], nothing is removed.
+// Returns the removed dirs, and the total size of their files.
+// [parentDir] defaults to the runner dir of the temp dir if empty.
+func PruneNetworkRootDirs(parentDir string, dryRun bool) ([]string, int64, error) {
+	if parentDir == "" {
+		parentDir = filepath.Join(os.TempDir(), constants.RootDirPrefix)
+	}
+	entries, err := os.ReadDir(parentDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, 0, nil
+		}
+		return nil, 0, err
+	}
+	pruned := []string{}
+	prunedSize := int64(0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		rootDir := filepath.Join(parentDir, entry.Name())
+		stale, err := isStaleNetworkRootDir(rootDir)
+		if err != nil {
+			return pruned, prunedSize, err
+		}
+		if !stale {
+			continue
+		}
+		size, err := getDirSize(rootDir)
+		if err != nil {
+			return pruned, prunedSize, err
+		}
+		if !dryRun {
+			if err := os.RemoveAll(rootDir); err != nil {
+				return pruned, prunedSize, fmt.Errorf("failure removing network root dir %q: %w", rootDir, err)
+			}
+		}
+		pruned = append(pruned, rootDir)
+		prunedSize += size
+	}
+	return pruned, prunedSize, nil
+}
+
+// Returns true if neither the owner of [rootDir], nor the nodes that wrote
+// their process context in its node dirs, are running.
+// Dirs created before owners were recorded are stale once none of their
+// nodes is running. Dirs with neither an owner nor node process contexts
+// are not network root dirs, and are kept.
+func isStaleNetworkRootDir(rootDir string) (bool, error) {
+	hasOwner := true
+	owner, err := readProcessContext(filepath.Join(rootDir, rootDirOwnerFileName))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		hasOwner = false
+	case err != nil:
+		return false, err
+	case isProcessRunning(owner.PID):
+		return false, nil
+	}
+	// nodes may outlive a runner that was killed
+	nodeContextFiles, err := filepath.Glob(filepath.Join(rootDir, "*", config.DefaultProcessContextFilename))
+	if err != nil {
+		return false, err
+	}
+	if !hasOwner && len(nodeContextFiles) == 0 {
+		return false, nil
+	}
+	for _, nodeContextFile := range nodeContextFiles {
+		node, err := readProcessContext(nodeContextFile)
+		if err != nil {
+			// may be being written
+			return false, nil
+		}
+		if isProcessRunning(node.PID) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func readProcessContext(path string) (*processContext, error) {
+	contextJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	processContext := &processContext{}
+	if err := json.Unmarshal(contextJSON, processContext); err != nil {
+		return nil, fmt.Errorf("failure reading %q: %w", path, err)
+	}
+	return processContext, nil
+}
+
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	// signal 0 only checks that the process exists
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
@@ -0,0 +1,78 @@
+package local
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/config"
+	"github.com/stretchr/testify/require"
+)
+
+// Returns the pid of a process that is no longer running
+func getExitedPID(t *testing.T) int {
+	cmd := exec.Command("true")
+	require.NoError(t, cmd.Run())
+	return cmd.Process.Pid
+}
+
+func writeProcessContext(t *testing.T, path string, pid int) {
+	contextJSON, err := json.Marshal(processContext{PID: pid})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.NoError(t, os.WriteFile(path, contextJSON, 0o600))
+}
+
+func TestPruneNetworkRootDirs(t *testing.T) {
+	require := require.New(t)
+
+	parentDir := t.TempDir()
+	exitedPID := getExitedPID(t)
+
+	// owned by this process
+	runningDir, err := NewNetworkRootDir(parentDir, "network")
+	require.NoError(err)
+	// owner exited
+	staleDir := filepath.Join(parentDir, "network-stale")
+	writeProcessContext(t, filepath.Join(staleDir, rootDirOwnerFileName), exitedPID)
+	writeProcessContext(t, filepath.Join(staleDir, "node1", config.DefaultProcessContextFilename), exitedPID)
+	require.NoError(os.WriteFile(filepath.Join(staleDir, "node1", "db"), []byte("db"), 0o600))
+	// owner exited, but a node is still running
+	orphanDir := filepath.Join(parentDir, "network-orphan")
+	writeProcessContext(t, filepath.Join(orphanDir, rootDirOwnerFileName), exitedPID)
+	writeProcessContext(t, filepath.Join(orphanDir, "node1", config.DefaultProcessContextFilename), os.Getpid())
+	// created before owners were recorded, and no node is running
+	legacyDir := filepath.Join(parentDir, "network-legacy")
+	writeProcessContext(t, filepath.Join(legacyDir, "node1", config.DefaultProcessContextFilename), exitedPID)
+	writeProcessContext(t, filepath.Join(legacyDir, "node2", config.DefaultProcessContextFilename), exitedPID)
+	// created before owners were recorded, and a node is still running
+	legacyRunningDir := filepath.Join(parentDir, "network-legacy-running")
+	writeProcessContext(t, filepath.Join(legacyRunningDir, "node1", config.DefaultProcessContextFilename), exitedPID)
+	writeProcessContext(t, filepath.Join(legacyRunningDir, "node2", config.DefaultProcessContextFilename), os.Getpid())
+	// neither an owner nor nodes
+	unknownDir := filepath.Join(parentDir, "network-unknown")
+	require.NoError(os.MkdirAll(unknownDir, os.ModePerm))
+
+	pruned, size, err := PruneNetworkRootDirs(parentDir, true)
+	require.NoError(err)
+	require.Equal([]string{legacyDir, staleDir}, pruned)
+	require.Positive(size)
+	require.DirExists(staleDir)
+
+	pruned, _, err = PruneNetworkRootDirs(parentDir, false)
+	require.NoError(err)
+	require.Equal([]string{legacyDir, staleDir}, pruned)
+	require.NoDirExists(staleDir)
+	require.NoDirExists(legacyDir)
+	require.DirExists(legacyRunningDir)
+	require.DirExists(runningDir)
+	require.DirExists(orphanDir)
+	require.DirExists(unknownDir)
+
+	pruned, size, err = PruneNetworkRootDirs(filepath.Join(parentDir, "missing"), false)
+	require.NoError(err)
+	require.Empty(pruned)
+	require.Zero(size)
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 	"syscall"
 
 	"github.com/DioneProtocol/odyssey-network-runner/api"
@@ -18,7 +17,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
-	dircopy "github.com/otiai10/copy"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 )
 
@@ -27,6 +27,34 @@ const (
 	deprecatedWhitelistedSubnetsKey = "whitelisted-subnets"
 )
 
+// SnapshotFormatVersion is the version of the layout of the snapshots saved
+// by this runner. Snapshots with an older version are upgraded by the
+// [snapshotMigrations] that follow it.
+const SnapshotFormatVersion = 2
+
+var ErrUnsupportedSnapshotFormat = errors.New("unsupported snapshot format")
+
+// snapshotMigration upgrades a snapshot dir to the format version given by
+// its position in [snapshotMigrations], from the previous one.
+// Migrations must be idempotent, as the format version is only recorded
+// once all of them are applied.
+type snapshotMigration struct {
+	description string
+	migrate     func(snapshotDir string) error
+}
+
+// The migration to format version N is at index N-1
+var snapshotMigrations = []snapshotMigration{
+	{
+		description: "replace deprecated odysseygo flags",
+		migrate:     migrateDeprecatedOdygoFlags,
+	},
+	{
+		description: "add metadata and checksums",
+		migrate:     migrateSnapshotMetadata,
+	},
+}
+
 // NetworkState defines dynamic network information not available on blockchain db
 type NetworkState struct {
 	// Map from subnet id to elastic subnet tx id
@@ -64,12 +92,15 @@ func NewNetworkFromSnapshot(
 	snapshotName string,
 	rootDir string,
 	snapshotsDir string,
+	snapshotStore SnapshotStore,
 	binaryPath string,
 	pluginDir string,
 	chainConfigs map[string]string,
 	upgradeConfigs map[string]string,
 	subnetConfigs map[string]string,
 	flags map[string]interface{},
+	topology SnapshotTopology,
+	allowIncompatible bool,
 	reassignPortsIfUsed bool,
 ) (network.Network, error) {
 	net, err := newNetwork(
@@ -83,6 +114,7 @@ func NewNetworkFromSnapshot(
 		},
 		rootDir,
 		snapshotsDir,
+		snapshotStore,
 		reassignPortsIfUsed,
 	)
 	if err != nil {
@@ -97,16 +129,49 @@ func NewNetworkFromSnapshot(
 		upgradeConfigs,
 		subnetConfigs,
 		flags,
+		topology,
+		allowIncompatible,
 	)
 	return net, err
 }
 
-// Save network snapshot
+// Save network snapshot, described by [description] and [labels]
 // Network is stopped in order to do a safe preservation
-func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (string, error) {
+func (ln *localNetwork) SaveSnapshot(
+	ctx context.Context,
+	snapshotName string,
+	description string,
+	labels map[string]string,
+) (string, error) {
 	ln.lock.Lock()
 	defer ln.lock.Unlock()
 
+	return ln.saveSnapshot(ctx, snapshotName, description, labels, false)
+}
+
+// Save network snapshot, described by [description] and [labels]
+// Nodes are stopped in order to do a safe preservation, and then restarted
+// with the same ports, keys and configs. Returns once they are healthy.
+func (ln *localNetwork) SaveSnapshotAndContinue(
+	ctx context.Context,
+	snapshotName string,
+	description string,
+	labels map[string]string,
+) (string, error) {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	return ln.saveSnapshot(ctx, snapshotName, description, labels, true)
+}
+
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) saveSnapshot(
+	ctx context.Context,
+	snapshotName string,
+	description string,
+	labels map[string]string,
+	keepRunning bool,
+) (string, error) {
 	if ln.stopCalled() {
 		return "", network.ErrStopped
 	}
@@ -118,6 +183,11 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 	if _, err := os.Stat(snapshotDir); err == nil {
 		return "", fmt.Errorf("snapshot %q already exists", snapshotName)
 	}
+	if _, err := ln.snapshotStore.GetMetadata(ctx, snapshotName); err == nil {
+		return "", fmt.Errorf("snapshot %q already exists", snapshotName)
+	} else if !errors.Is(err, ErrSnapshotNotFound) {
+		return "", err
+	}
 	// keep copy of node info that will be removed by stop
 	nodesConfig := map[string]node.Config{}
 	nodesDBDir := map[string]string{}
@@ -152,26 +222,121 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 		nodesConfig[nodeName] = nodeConfig
 	}
 
-	// stop network to safely save snapshot
-	if err := ln.stop(ctx); err != nil {
+	// chains are read while nodes are running
+	subnets, err := ln.getSnapshotSubnets(ctx)
+	if err != nil {
+		ln.log.Warn("couldn't get subnets for snapshot metadata", zap.Error(err))
+		subnets = []SnapshotSubnet{}
+	}
+	vmVersions, err := ln.getSnapshotVMVersions(ctx)
+	if err != nil {
+		ln.log.Warn("couldn't get vm versions for snapshot metadata", zap.Error(err))
+		vmVersions = map[string]string{}
+	}
+	metadata := &SnapshotMetadata{
+		Description: description,
+		Labels:      maps.Clone(labels),
+		Subnets:     subnets,
+		VMVersions:  vmVersions,
+	}
+
+	if !keepRunning {
+		// stop network to safely save snapshot
+		if err := ln.stop(ctx); err != nil {
+			return "", err
+		}
+		syscall.Sync()
+		if err := ln.writeSnapshot(snapshotDir, nodesConfig, nodesDBDir, networkConfigFlags, metadata); err != nil {
+			return "", err
+		}
+		if err := ln.storeSnapshot(ctx, snapshotName); err != nil {
+			return "", err
+		}
+		return snapshotDir, nil
+	}
+
+	// pause running nodes to safely save snapshot
+	pausedNodeNames := []string{}
+	for nodeName, node := range ln.nodes {
+		if node.paused {
+			continue
+		}
+		pauseCtx, pauseCtxCancel := context.WithTimeout(ctx, stopTimeout)
+		err := ln.pauseNode(pauseCtx, nodeName)
+		pauseCtxCancel()
+		if err != nil {
+			_ = ln.resumeNodes(ctx, pausedNodeNames)
+			return "", err
+		}
+		pausedNodeNames = append(pausedNodeNames, nodeName)
+	}
+	// nodes are resumed even if the snapshot couldn't be written
+	writeErr := ln.writeSnapshot(snapshotDir, nodesConfig, nodesDBDir, networkConfigFlags, metadata)
+	if err := ln.resumeNodes(ctx, pausedNodeNames); err != nil {
+		return "", err
+	}
+	if writeErr != nil {
+		return "", writeErr
+	}
+	if err := ln.healthy(ctx); err != nil {
 		return "", err
 	}
-	syscall.Sync()
+	if err := ln.storeSnapshot(ctx, snapshotName); err != nil {
+		return "", err
+	}
+	return snapshotDir, nil
+}
+
+// Puts the saved snapshot [snapshotName] in the snapshot store. If that
+// fails, it is removed from the snapshots dir, so it can be saved again.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) storeSnapshot(ctx context.Context, snapshotName string) error {
+	if err := ln.snapshotStore.Put(ctx, snapshotName, ln.snapshotsDir); err != nil {
+		_ = os.RemoveAll(getSnapshotDir(ln.snapshotsDir, snapshotName))
+		_ = pruneSnapshotObjects(ln.snapshotsDir)
+		return fmt.Errorf("failure storing snapshot %q: %w", snapshotName, err)
+	}
+	return nil
+}
+
+// Resumes the paused nodes [nodeNames].
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) resumeNodes(ctx context.Context, nodeNames []string) error {
+	errs := wrappers.Errs{}
+	for _, nodeName := range nodeNames {
+		if err := ln.resumeNode(ctx, nodeName); err != nil {
+			ln.log.Error("error resuming node", zap.String("name", nodeName), zap.Error(err))
+			errs.Add(err)
+		}
+	}
+	return errs.Err
+}
+
+// Writes the snapshot dir [snapshotDir] from the stopped or paused nodes.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) writeSnapshot(
+	snapshotDir string,
+	nodesConfig map[string]node.Config,
+	nodesDBDir map[string]string,
+	networkConfigFlags map[string]interface{},
+	metadata *SnapshotMetadata,
+) error {
 	// create main snapshot dirs
 	snapshotDBDir := filepath.Join(snapshotDir, defaultDBSubdir)
 	if err := os.MkdirAll(snapshotDBDir, os.ModePerm); err != nil {
-		return "", err
+		return err
 	}
-	// save db
+	// save db, sharing the immutable files with other snapshots
+	copier := newDBCopier(ln.log, filepath.Join(ln.snapshotsDir, snapshotObjectsDir))
 	for _, nodeConfig := range nodesConfig {
 		sourceDBDir, ok := nodesDBDir[nodeConfig.Name]
 		if !ok {
-			return "", fmt.Errorf("failure obtaining db path for node %q", nodeConfig.Name)
+			return fmt.Errorf("failure obtaining db path for node %q", nodeConfig.Name)
 		}
 		sourceDBDir = filepath.Join(sourceDBDir, constants.NetworkName(ln.networkID))
 		targetDBDir := filepath.Join(filepath.Join(snapshotDBDir, nodeConfig.Name), constants.NetworkName(ln.networkID))
-		if err := dircopy.Copy(sourceDBDir, targetDBDir); err != nil {
-			return "", fmt.Errorf("failure saving node %q db dir: %w", nodeConfig.Name, err)
+		if err := copier.copyDir(sourceDBDir, targetDBDir); err != nil {
+			return fmt.Errorf("failure saving node %q db dir: %w", nodeConfig.Name, err)
 		}
 	}
 	// save network conf
@@ -183,16 +348,17 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 		ChainConfigFiles:   ln.chainConfigFiles,
 		UpgradeConfigFiles: ln.upgradeConfigFiles,
 		SubnetConfigFiles:  ln.subnetConfigFiles,
+		FaultInjection:     ln.faultProxy != nil,
 	}
 
 	// no need to save this, will be generated automatically on snapshot load
 	networkConfig.NodeConfigs = append(networkConfig.NodeConfigs, maps.Values(nodesConfig)...)
 	networkConfigJSON, err := json.MarshalIndent(networkConfig, "", "    ")
 	if err != nil {
-		return "", err
+		return err
 	}
 	if err := createFileAndWrite(filepath.Join(snapshotDir, "network.json"), networkConfigJSON); err != nil {
-		return "", err
+		return err
 	}
 	// save dynamic part of network not available on blockchain
 	subnetID2ElasticSubnetID := map[string]string{}
@@ -204,15 +370,21 @@ func (ln *localNetwork) SaveSnapshot(ctx context.Context, snapshotName string) (
 	}
 	networkStateJSON, err := json.MarshalIndent(networkState, "", "    ")
 	if err != nil {
-		return "", err
+		return err
 	}
 	if err := createFileAndWrite(filepath.Join(snapshotDir, "state.json"), networkStateJSON); err != nil {
-		return "", err
+		return err
 	}
-	return snapshotDir, nil
+	if err := writeSnapshotChecksums(snapshotDir); err != nil {
+		return err
+	}
+	return ln.writeSnapshotMetadata(snapshotDir, nodesConfig, metadata)
 }
 
-// start network from snapshot
+// start network from snapshot, with the nodes given by [topology].
+// Fails if the snapshot files don't match their checksums, or if the node
+// binaries or VM plugins are incompatible with the snapshot, unless
+// [allowIncompatible].
 func (ln *localNetwork) loadSnapshot(
 	ctx context.Context,
 	snapshotName string,
@@ -222,19 +394,39 @@ func (ln *localNetwork) loadSnapshot(
 	upgradeConfigs map[string]string,
 	subnetConfigs map[string]string,
 	flags map[string]interface{},
+	topology SnapshotTopology,
+	allowIncompatible bool,
 ) error {
 	ln.lock.Lock()
 	defer ln.lock.Unlock()
 
+	// fetched into the snapshots dir, if not already there
+	if err := ln.snapshotStore.Get(ctx, snapshotName, ln.snapshotsDir); err != nil {
+		if errors.Is(err, ErrSnapshotNotFound) {
+			return err
+		}
+		return fmt.Errorf("failure fetching snapshot %q: %w", snapshotName, err)
+	}
 	snapshotDir := filepath.Join(ln.snapshotsDir, snapshotPrefix+snapshotName)
 	snapshotDBDir := filepath.Join(snapshotDir, defaultDBSubdir)
-	_, err := os.Stat(snapshotDir)
+	if err := ln.verifySnapshotChecksums(snapshotDir); err != nil {
+		return fmt.Errorf("failure verifying snapshot %q: %w", snapshotName, err)
+	}
+	// upgrades the local copy, which is the stored one for local stores
+	migration, err := MigrateSnapshot(ln.snapshotsDir, snapshotName, false)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return ErrSnapshotNotFound
-		} else {
-			return fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
-		}
+		return err
+	}
+	if len(migration.Applied) > 0 {
+		ln.log.Info("snapshot migrated",
+			zap.String("snapshot-name", snapshotName),
+			zap.Uint32("from-version", migration.FromVersion),
+			zap.Strings("migrations", migration.Applied),
+		)
+	}
+	metadata, err := GetSnapshotMetadata(ln.snapshotsDir, snapshotName)
+	if err != nil {
+		return err
 	}
 	// load network config
 	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, "network.json"))
@@ -245,14 +437,22 @@ func (ln *localNetwork) loadSnapshot(
 	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
 		return fmt.Errorf("failure unmarshalling network config from snapshot: %w", err)
 	}
-	// fix deprecated odygo flags
-	if err := fixDeprecatedOdygoFlags(networkConfig.Flags); err != nil {
+	// apply topology. extra nodes go last, and get the same overrides as
+	// the recorded ones
+	networkConfig.NodeConfigs, err = topology.filterNodes(networkConfig.NodeConfigs)
+	if err != nil {
 		return err
 	}
-	for i := range networkConfig.NodeConfigs {
-		if err := fixDeprecatedOdygoFlags(networkConfig.NodeConfigs[i].Flags); err != nil {
-			return err
+	numRecordedNodes := len(networkConfig.NodeConfigs)
+	for _, nodeConfig := range topology.ExtraNodes {
+		nodeConfig.Flags = maps.Clone(nodeConfig.Flags)
+		if nodeConfig.Flags == nil {
+			nodeConfig.Flags = map[string]interface{}{}
 		}
+		nodeConfig.ChainConfigFiles = maps.Clone(nodeConfig.ChainConfigFiles)
+		nodeConfig.UpgradeConfigFiles = maps.Clone(nodeConfig.UpgradeConfigFiles)
+		nodeConfig.SubnetConfigFiles = maps.Clone(nodeConfig.SubnetConfigFiles)
+		networkConfig.NodeConfigs = append(networkConfig.NodeConfigs, nodeConfig)
 	}
 	// add flags
 	for i := range networkConfig.NodeConfigs {
@@ -260,15 +460,6 @@ func (ln *localNetwork) loadSnapshot(
 			networkConfig.NodeConfigs[i].Flags[k] = v
 		}
 	}
-	// load db
-	for _, nodeConfig := range networkConfig.NodeConfigs {
-		sourceDBDir := filepath.Join(snapshotDBDir, nodeConfig.Name)
-		targetDBDir := filepath.Join(filepath.Join(ln.rootDir, nodeConfig.Name), defaultDBSubdir)
-		if err := dircopy.Copy(sourceDBDir, targetDBDir); err != nil {
-			return fmt.Errorf("failure loading node %q db dir: %w", nodeConfig.Name, err)
-		}
-		nodeConfig.Flags[config.DBPathKey] = targetDBDir
-	}
 	// replace binary path
 	if binaryPath != "" {
 		for i := range networkConfig.NodeConfigs {
@@ -281,6 +472,19 @@ func (ln *localNetwork) loadSnapshot(
 			networkConfig.NodeConfigs[i].Flags[config.PluginDirKey] = pluginDir
 		}
 	}
+	if err := ln.checkSnapshotCompatibility(metadata, networkConfig, allowIncompatible); err != nil {
+		return err
+	}
+	// load db, sharing the immutable files with the snapshot
+	copier := newDBCopier(ln.log, "")
+	for _, nodeConfig := range networkConfig.NodeConfigs[:numRecordedNodes] {
+		sourceDBDir := filepath.Join(snapshotDBDir, nodeConfig.Name)
+		targetDBDir := filepath.Join(filepath.Join(ln.rootDir, nodeConfig.Name), defaultDBSubdir)
+		if err := copier.copyDir(sourceDBDir, targetDBDir); err != nil {
+			return fmt.Errorf("failure loading node %q db dir: %w", nodeConfig.Name, err)
+		}
+		nodeConfig.Flags[config.DBPathKey] = targetDBDir
+	}
 	// add chain configs and upgrade configs
 	for i := range networkConfig.NodeConfigs {
 		if networkConfig.NodeConfigs[i].ChainConfigFiles == nil {
@@ -327,16 +531,66 @@ func (ln *localNetwork) loadSnapshot(
 			ln.subnetID2ElasticSubnetID[subnetID] = elasticSubnetID
 		}
 	}
-	return ln.loadConfig(ctx, networkConfig)
+	extraNodeConfigs := networkConfig.NodeConfigs[numRecordedNodes:]
+	networkConfig.NodeConfigs = networkConfig.NodeConfigs[:numRecordedNodes]
+	if err := ln.loadConfig(ctx, networkConfig); err != nil {
+		return err
+	}
+	// nodes are paused once they got to run with the loaded state
+	if len(topology.PausedNodes) > 0 {
+		healthyCtx, healthyCtxCancel := context.WithTimeout(ctx, loadHealthyTimeout)
+		err := ln.healthy(healthyCtx)
+		healthyCtxCancel()
+		if err != nil {
+			return ln.stopOnLoadError(ctx, err)
+		}
+	}
+	for _, nodeName := range topology.PausedNodes {
+		if err := ln.pauseNode(ctx, nodeName); err != nil {
+			return ln.stopOnLoadError(ctx, fmt.Errorf("failure pausing node %q: %w", nodeName, err))
+		}
+	}
+	for _, nodeConfig := range extraNodeConfigs {
+		if nodeConfig.Name == "" {
+			nodeConfig.Name = ln.newExtraNodeName(topology.DropNodes)
+		}
+		if _, err := ln.addNode(nodeConfig); err != nil {
+			return ln.stopOnLoadError(ctx, fmt.Errorf("error adding extra node %s: %w", nodeConfig.Name, err))
+		}
+	}
+	return nil
 }
 
-// Remove network snapshot
+// Stops the network partially loaded from a snapshot, and returns [err].
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) stopOnLoadError(ctx context.Context, err error) error {
+	if stopErr := ln.stop(ctx); stopErr != nil {
+		ln.log.Debug("error stopping network", zap.Error(stopErr))
+	}
+	return err
+}
+
+// Remove network snapshot, from the snapshot store and the snapshots dir
 func (ln *localNetwork) RemoveSnapshot(snapshotName string) error {
-	snapshotDir := filepath.Join(ln.snapshotsDir, snapshotPrefix+snapshotName)
+	return removeStoredSnapshot(context.Background(), ln.snapshotStore, ln.snapshotsDir, snapshotName)
+}
+
+// Removes the snapshot [snapshotName] from [store], and its copy from the
+// local snapshots dir [snapshotsDir].
+func removeStoredSnapshot(ctx context.Context, store SnapshotStore, snapshotsDir string, snapshotName string) error {
+	if snapshotsDir == "" {
+		snapshotsDir = defaultSnapshotsDir
+	}
+	storeErr := store.Remove(ctx, snapshotName)
+	if storeErr != nil && !errors.Is(storeErr, ErrSnapshotNotFound) {
+		return storeErr
+	}
+	// local copy of a snapshot fetched from another store
+	snapshotDir := filepath.Join(snapshotsDir, snapshotPrefix+snapshotName)
 	_, err := os.Stat(snapshotDir)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			return ErrSnapshotNotFound
+			return storeErr
 		} else {
 			return fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
 		}
@@ -344,26 +598,159 @@ func (ln *localNetwork) RemoveSnapshot(snapshotName string) error {
 	if err := os.RemoveAll(snapshotDir); err != nil {
 		return fmt.Errorf("failure removing snapshot path %q: %w", snapshotDir, err)
 	}
-	return nil
+	return pruneSnapshotObjects(snapshotsDir)
 }
 
-// Get network snapshots
+// Get network snapshots of the snapshot store
 func (ln *localNetwork) GetSnapshotNames() ([]string, error) {
-	_, err := os.Stat(ln.snapshotsDir)
+	return ln.snapshotStore.List(context.Background())
+}
+
+// SnapshotMigration describes the upgrade of a snapshot to the current
+// format version.
+type SnapshotMigration struct {
+	FromVersion uint32
+	ToVersion   uint32
+	// Descriptions of the migrations applied, in order
+	Applied []string
+}
+
+// MigrateSnapshot upgrades the snapshot [snapshotName] of [snapshotsDir] to
+// SnapshotFormatVersion, step by step. If [dryRun], only the migrations to
+// apply are returned. Fails with ErrUnsupportedSnapshotFormat if the snapshot
+// was saved by a newer runner.
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func MigrateSnapshot(snapshotsDir string, snapshotName string, dryRun bool) (*SnapshotMigration, error) {
+	metadata, err := GetSnapshotMetadata(snapshotsDir, snapshotName)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, fmt.Errorf("snapshots dir %q does not exists", ln.snapshotsDir)
-		} else {
-			return nil, fmt.Errorf("failure accessing snapshots dir %q: %w", ln.snapshotsDir, err)
+		return nil, err
+	}
+	if metadata.FormatVersion > SnapshotFormatVersion {
+		return nil, fmt.Errorf(
+			"%w: snapshot %q has format version %d, newer than the supported %d",
+			ErrUnsupportedSnapshotFormat, snapshotName, metadata.FormatVersion, SnapshotFormatVersion,
+		)
+	}
+	migration := &SnapshotMigration{
+		FromVersion: metadata.FormatVersion,
+		ToVersion:   SnapshotFormatVersion,
+		Applied:     []string{},
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	for version := metadata.FormatVersion; version < SnapshotFormatVersion; version++ {
+		step := snapshotMigrations[version]
+		if !dryRun {
+			if err := step.migrate(snapshotDir); err != nil {
+				return nil, fmt.Errorf("failure migrating snapshot %q to format version %d: %w", snapshotName, version+1, err)
+			}
 		}
+		migration.Applied = append(migration.Applied, step.description)
 	}
-	matches, err := filepath.Glob(filepath.Join(ln.snapshotsDir, snapshotPrefix+"*"))
+	if dryRun || len(migration.Applied) == 0 {
+		return migration, nil
+	}
+	metadata, err = GetSnapshotMetadata(snapshotsDir, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+	metadata.FormatVersion = SnapshotFormatVersion
+	metadataJSON, err := json.MarshalIndent(metadata, "", "    ")
 	if err != nil {
 		return nil, err
 	}
-	snapshots := []string{}
-	for _, match := range matches {
-		snapshots = append(snapshots, strings.TrimPrefix(filepath.Base(match), snapshotPrefix))
+	if err := replaceFile(filepath.Join(snapshotDir, snapshotMetadataName), metadataJSON); err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// MigrateStoredSnapshot is MigrateSnapshot for [store]: the snapshot is
+// fetched into [snapshotsDir], migrated there, and put back in [store].
+func MigrateStoredSnapshot(
+	ctx context.Context,
+	store SnapshotStore,
+	snapshotsDir string,
+	snapshotName string,
+	dryRun bool,
+) (*SnapshotMigration, error) {
+	if err := store.Get(ctx, snapshotName, snapshotsDir); err != nil {
+		return nil, err
+	}
+	migration, err := MigrateSnapshot(snapshotsDir, snapshotName, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun || len(migration.Applied) == 0 {
+		return migration, nil
+	}
+	if err := store.Put(ctx, snapshotName, snapshotsDir); err != nil {
+		return nil, fmt.Errorf("failure storing migrated snapshot %q: %w", snapshotName, err)
+	}
+	return migration, nil
+}
+
+// Moves the values of the deprecated odysseygo flags of the network and
+// node configs of [snapshotDir] to the flags that replace them.
+func migrateDeprecatedOdygoFlags(snapshotDir string) error {
+	networkConfigPath := filepath.Join(snapshotDir, snapshotNetworkConfigName)
+	networkConfigJSON, err := os.ReadFile(networkConfigPath)
+	if err != nil {
+		return err
+	}
+	networkConfig := network.Config{}
+	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
+		return err
+	}
+	if err := fixDeprecatedOdygoFlags(networkConfig.Flags); err != nil {
+		return err
+	}
+	for i := range networkConfig.NodeConfigs {
+		if err := fixDeprecatedOdygoFlags(networkConfig.NodeConfigs[i].Flags); err != nil {
+			return err
+		}
+	}
+	networkConfigJSON, err = json.MarshalIndent(networkConfig, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := replaceFile(networkConfigPath, networkConfigJSON); err != nil {
+		return err
+	}
+	return updateSnapshotChecksum(snapshotDir, snapshotNetworkConfigName)
+}
+
+// Writes the metadata and the checksums of [snapshotDir], if missing.
+func migrateSnapshotMetadata(snapshotDir string) error {
+	metadataPath := filepath.Join(snapshotDir, snapshotMetadataName)
+	if _, err := os.Stat(metadataPath); errors.Is(err, os.ErrNotExist) {
+		metadata, err := deriveSnapshotMetadata(snapshotDir)
+		if err != nil {
+			return err
+		}
+		metadataJSON, err := json.MarshalIndent(metadata, "", "    ")
+		if err != nil {
+			return err
+		}
+		if err := replaceFile(metadataPath, metadataJSON); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(snapshotDir, snapshotChecksumsName)); errors.Is(err, os.ErrNotExist) {
+		return writeSnapshotChecksums(snapshotDir)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Writes [contents] to [path] through a temp file, so that [path] is either
+// left as is or fully replaced, and files linked to it are not modified.
+func replaceFile(path string, contents []byte) error {
+	tmpPath := path + ".tmp"
+	if err := createFileAndWrite(tmpPath, contents); err != nil {
+		return err
 	}
-	return snapshots, nil
+	return os.Rename(tmpPath, path)
 }
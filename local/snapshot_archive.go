@@ -0,0 +1,440 @@
+package local
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+)
+
+const (
+	// Name of the first entry of a snapshot archive
+	snapshotArchiveManifestName = "manifest.json"
+	// Version of the snapshot archive layout
+	snapshotArchiveFormatVersion = 1
+	snapshotNetworkConfigName    = "network.json"
+)
+
+var (
+	ErrSnapshotExists          = errors.New("snapshot already exists")
+	ErrInvalidSnapshotArchive  = errors.New("invalid snapshot archive")
+	ErrSnapshotArchiveMismatch = errors.New("snapshot archive doesn't match its manifest")
+)
+
+// SnapshotArchiveManifest is the first entry of a snapshot archive, and
+// describes the rest of it.
+type SnapshotArchiveManifest struct {
+	FormatVersion uint32 `json:"formatVersion"`
+	SnapshotName  string `json:"snapshotName"`
+	// Version of the OdysseyGo binary of the snapshot. Empty if the
+	// binary was not available on export.
+	OdysseyGoVersion string    `json:"odysseygoVersion"`
+	RunnerVersion    string    `json:"runnerVersion"`
+	CreatedAt        time.Time `json:"createdAt"`
+	// Files of the snapshot dir, in archive order
+	Files []SnapshotArchiveFile `json:"files"`
+}
+
+type SnapshotArchiveFile struct {
+	// Path relative to the snapshot dir, slash separated
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ExportSnapshot writes the snapshot [snapshotName] of [snapshotsDir] to [w]
+// as a gzipped tar archive: a manifest with the checksum of every file,
+// followed by the files of the snapshot dir.
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func ExportSnapshot(
+	snapshotsDir string,
+	snapshotName string,
+	runnerVersion string,
+	w io.Writer,
+) (*SnapshotArchiveManifest, error) {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return nil, err
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	if _, err := os.Stat(filepath.Join(snapshotDir, snapshotNetworkConfigName)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
+	}
+
+	manifest := &SnapshotArchiveManifest{
+		FormatVersion:    snapshotArchiveFormatVersion,
+		SnapshotName:     snapshotName,
+		OdysseyGoVersion: getSnapshotNodeVersion(snapshotDir),
+		RunnerVersion:    runnerVersion,
+		CreatedAt:        time.Now().UTC(),
+	}
+	// checksums come first, so the files are read twice
+	err := filepath.WalkDir(snapshotDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("unexpected non regular file %q in snapshot", filePath)
+		}
+		relPath, err := filepath.Rel(snapshotDir, filePath)
+		if err != nil {
+			return err
+		}
+		size, checksum, err := hashFile(filePath)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, SnapshotArchiveFile{
+			Path:   filepath.ToSlash(relPath),
+			Size:   size,
+			SHA256: checksum,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failure reading snapshot %q: %w", snapshotName, err)
+	}
+	sort.Slice(manifest.Files, func(i, j int) bool {
+		return manifest.Files[i].Path < manifest.Files[j].Path
+	})
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	manifestJSON, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    snapshotArchiveManifestName,
+		Mode:    0o644,
+		Size:    int64(len(manifestJSON)),
+		ModTime: manifest.CreatedAt,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(manifestJSON); err != nil {
+		return nil, err
+	}
+	for _, file := range manifest.Files {
+		if err := writeArchiveFile(tw, snapshotDir, file); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// ExportStoredSnapshot is ExportSnapshot for the snapshot [snapshotName]
+// of [store], which is fetched into [snapshotsDir] first.
+func ExportStoredSnapshot(
+	ctx context.Context,
+	store SnapshotStore,
+	snapshotsDir string,
+	snapshotName string,
+	runnerVersion string,
+	w io.Writer,
+) (*SnapshotArchiveManifest, error) {
+	if err := store.Get(ctx, snapshotName, snapshotsDir); err != nil {
+		return nil, err
+	}
+	return ExportSnapshot(snapshotsDir, snapshotName, runnerVersion, w)
+}
+
+// ImportSnapshot reads a snapshot archive from [r] and registers it in
+// [snapshotsDir] as [snapshotName], or as the snapshot name of the archive
+// if empty. The snapshot is only registered once all the files of the
+// archive match its manifest.
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func ImportSnapshot(snapshotsDir string, snapshotName string, r io.Reader) (*SnapshotArchiveManifest, error) {
+	return importSnapshot(snapshotsDir, snapshotName, r, nil)
+}
+
+// ImportStoredSnapshot is ImportSnapshot for [store]: the snapshot is
+// registered in [snapshotsDir], and then put in [store]. Fails with
+// ErrSnapshotExists if [store] already has the snapshot.
+func ImportStoredSnapshot(
+	ctx context.Context,
+	store SnapshotStore,
+	snapshotsDir string,
+	snapshotName string,
+	r io.Reader,
+) (*SnapshotArchiveManifest, error) {
+	manifest, err := importSnapshot(snapshotsDir, snapshotName, r, func(snapshotName string) error {
+		if _, err := store.GetMetadata(ctx, snapshotName); err == nil {
+			return fmt.Errorf("%w: %q", ErrSnapshotExists, snapshotName)
+		} else if !errors.Is(err, ErrSnapshotNotFound) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, manifest.SnapshotName, snapshotsDir); err != nil {
+		_ = os.RemoveAll(getSnapshotDir(snapshotsDir, manifest.SnapshotName))
+		return nil, fmt.Errorf("failure storing snapshot %q: %w", manifest.SnapshotName, err)
+	}
+	return manifest, nil
+}
+
+// See ImportSnapshot. [checkName], if not nil, can reject the snapshot name
+// before the files are extracted.
+func importSnapshot(
+	snapshotsDir string,
+	snapshotName string,
+	r io.Reader,
+	checkName func(string) error,
+) (*SnapshotArchiveManifest, error) {
+	if snapshotsDir == "" {
+		snapshotsDir = defaultSnapshotsDir
+	}
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotArchive, err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	manifest, err := readArchiveManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotName == "" {
+		snapshotName = manifest.SnapshotName
+	}
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return nil, err
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	if _, err := os.Stat(snapshotDir); err == nil {
+		return nil, fmt.Errorf("%w: %q", ErrSnapshotExists, snapshotName)
+	}
+	if checkName != nil {
+		if err := checkName(snapshotName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(snapshotsDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	// extracted next to its final location, and renamed once verified
+	tmpDir, err := os.MkdirTemp(snapshotsDir, ".import-"+snapshotName+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	if err := os.Chmod(tmpDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	expected := map[string]SnapshotArchiveFile{}
+	for _, file := range manifest.Files {
+		expected[file.Path] = file
+	}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotArchive, err)
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		file, ok := expected[hdr.Name]
+		if !ok || hdr.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("%w: unexpected entry %q", ErrSnapshotArchiveMismatch, hdr.Name)
+		}
+		delete(expected, hdr.Name)
+		if err := extractArchiveFile(tr, tmpDir, file); err != nil {
+			return nil, err
+		}
+	}
+	if len(expected) != 0 {
+		missing := make([]string, 0, len(expected))
+		for filePath := range expected {
+			missing = append(missing, filePath)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: missing files %q", ErrSnapshotArchiveMismatch, missing)
+	}
+
+	if err := os.Rename(tmpDir, snapshotDir); err != nil {
+		return nil, fmt.Errorf("failure registering snapshot %q: %w", snapshotName, err)
+	}
+	manifest.SnapshotName = snapshotName
+	return manifest, nil
+}
+
+func checkSnapshotName(snapshotName string) error {
+	if snapshotName == "" || snapshotName == "." || snapshotName == ".." || strings.ContainsAny(snapshotName, `/\`) {
+		return fmt.Errorf("invalid snapshot name %q", snapshotName)
+	}
+	return nil
+}
+
+func getSnapshotDir(snapshotsDir string, snapshotName string) string {
+	if snapshotsDir == "" {
+		snapshotsDir = defaultSnapshotsDir
+	}
+	return filepath.Join(snapshotsDir, snapshotPrefix+snapshotName)
+}
+
+// Returns the version of the OdysseyGo binary of the snapshot, or an
+// empty string if it is not available.
+func getSnapshotNodeVersion(snapshotDir string) string {
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigName))
+	if err != nil {
+		return ""
+	}
+	networkConfig := network.Config{}
+	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
+		return ""
+	}
+	binaryPath := networkConfig.BinaryPath
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		if binaryPath == "" {
+			binaryPath = nodeConfig.BinaryPath
+		}
+	}
+	if binaryPath == "" {
+		return ""
+	}
+	nodeVersionOutput, err := (&nodeProcessCreator{}).GetNodeVersion(node.Config{BinaryPath: binaryPath})
+	if err != nil {
+		return ""
+	}
+	nodeSemVer, err := parseNodeSemVer(nodeVersionOutput, binaryPath)
+	if err != nil {
+		return ""
+	}
+	return nodeSemVer
+}
+
+// Returns the size and the hex encoded sha256 of the file at [filePath].
+func hashFile(filePath string) (int64, string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func writeArchiveFile(tw *tar.Writer, snapshotDir string, file SnapshotArchiveFile) error {
+	f, err := os.Open(filepath.Join(snapshotDir, filepath.FromSlash(file.Path)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() != file.Size {
+		return fmt.Errorf("snapshot file %q changed during export", file.Path)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    file.Path,
+		Mode:    int64(info.Mode().Perm()),
+		Size:    file.Size,
+		ModTime: info.ModTime(),
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func readArchiveManifest(tr *tar.Reader) (*SnapshotArchiveManifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSnapshotArchive, err)
+	}
+	if hdr.Name != snapshotArchiveManifestName {
+		return nil, fmt.Errorf("%w: first entry is %q instead of the manifest", ErrInvalidSnapshotArchive, hdr.Name)
+	}
+	manifest := &SnapshotArchiveManifest{}
+	if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("%w: couldn't decode manifest: %s", ErrInvalidSnapshotArchive, err)
+	}
+	if manifest.FormatVersion != snapshotArchiveFormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrInvalidSnapshotArchive, manifest.FormatVersion)
+	}
+	hasNetworkConfig := false
+	for _, file := range manifest.Files {
+		// entries must stay inside the snapshot dir
+		if path.IsAbs(file.Path) ||
+			path.Clean(file.Path) != file.Path ||
+			file.Path == "." ||
+			file.Path == ".." ||
+			strings.HasPrefix(file.Path, "../") {
+			return nil, fmt.Errorf("%w: invalid path %q", ErrInvalidSnapshotArchive, file.Path)
+		}
+		if file.Path == snapshotNetworkConfigName {
+			hasNetworkConfig = true
+		}
+	}
+	if !hasNetworkConfig {
+		return nil, fmt.Errorf("%w: no %s", ErrInvalidSnapshotArchive, snapshotNetworkConfigName)
+	}
+	return manifest, nil
+}
+
+// Writes the current entry of [tr] into [dir], and checks it against [file].
+func extractArchiveFile(tr *tar.Reader, dir string, file SnapshotArchiveFile) error {
+	filePath := filepath.Join(dir, filepath.FromSlash(file.Path))
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	// one more byte than expected is read, to detect longer entries
+	size, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(tr, file.Size+1))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSnapshotArchive, err)
+	}
+	if size != file.Size {
+		return fmt.Errorf("%w: %q has size %d instead of %d", ErrSnapshotArchiveMismatch, file.Path, size, file.Size)
+	}
+	if checksum := hex.EncodeToString(h.Sum(nil)); checksum != file.SHA256 {
+		return fmt.Errorf("%w: %q has checksum %s instead of %s", ErrSnapshotArchiveMismatch, file.Path, checksum, file.SHA256)
+	}
+	return nil
+}
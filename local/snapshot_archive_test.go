@@ -0,0 +1,132 @@
+package local
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testSnapshotFiles = map[string]string{
+	"network.json":                    `{"genesis":"{}"}`,
+	"state.json":                      `{}`,
+	"db/node1/network-1337/000001.db": "node1 db",
+	"db/node2/network-1337/000001.db": "node2 db",
+}
+
+func newTestSnapshot(t *testing.T, snapshotsDir string, snapshotName string) {
+	for filePath, content := range testSnapshotFiles {
+		filePath = filepath.Join(snapshotsDir, snapshotPrefix+snapshotName, filePath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), os.ModePerm))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+}
+
+// Returns [archive] with the content of its entry [name] replaced
+func rewriteArchive(t *testing.T, archive []byte, name string, content string) []byte {
+	require := require.New(t)
+
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(err)
+	tr := tar.NewReader(gr)
+	out := &bytes.Buffer{}
+	gw := gzip.NewWriter(out)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		data, err := io.ReadAll(tr)
+		require.NoError(err)
+		if hdr.Name == name {
+			data = []byte(content)
+			hdr.Size = int64(len(data))
+		}
+		require.NoError(tw.WriteHeader(hdr))
+		_, err = tw.Write(data)
+		require.NoError(err)
+	}
+	require.NoError(tw.Close())
+	require.NoError(gw.Close())
+	return out.Bytes()
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	require := require.New(t)
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+
+	archive := &bytes.Buffer{}
+	manifest, err := ExportSnapshot(srcDir, "snap", "v1.2.3", archive)
+	require.NoError(err)
+	require.Equal("snap", manifest.SnapshotName)
+	require.Equal("v1.2.3", manifest.RunnerVersion)
+	require.Len(manifest.Files, len(testSnapshotFiles))
+
+	dstDir := t.TempDir()
+	imported, err := ImportSnapshot(dstDir, "", bytes.NewReader(archive.Bytes()))
+	require.NoError(err)
+	require.Equal("snap", imported.SnapshotName)
+	for filePath, content := range testSnapshotFiles {
+		data, err := os.ReadFile(filepath.Join(dstDir, snapshotPrefix+"snap", filePath))
+		require.NoError(err)
+		require.Equal(content, string(data))
+	}
+
+	// already registered
+	_, err = ImportSnapshot(dstDir, "", bytes.NewReader(archive.Bytes()))
+	require.ErrorIs(err, ErrSnapshotExists)
+
+	// renamed
+	imported, err = ImportSnapshot(dstDir, "other", bytes.NewReader(archive.Bytes()))
+	require.NoError(err)
+	require.Equal("other", imported.SnapshotName)
+	require.DirExists(filepath.Join(dstDir, snapshotPrefix+"other"))
+
+	_, err = ExportSnapshot(srcDir, "missing", "", archive)
+	require.ErrorIs(err, ErrSnapshotNotFound)
+}
+
+func TestSnapshotArchiveVerification(t *testing.T) {
+	require := require.New(t)
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+	archive := &bytes.Buffer{}
+	_, err := ExportSnapshot(srcDir, "snap", "", archive)
+	require.NoError(err)
+
+	dstDir := t.TempDir()
+
+	// same size, different content
+	tampered := rewriteArchive(t, archive.Bytes(), "db/node1/network-1337/000001.db", "node9 db")
+	_, err = ImportSnapshot(dstDir, "", bytes.NewReader(tampered))
+	require.ErrorIs(err, ErrSnapshotArchiveMismatch)
+
+	// different size
+	tampered = rewriteArchive(t, archive.Bytes(), "state.json", "{ }")
+	_, err = ImportSnapshot(dstDir, "", bytes.NewReader(tampered))
+	require.ErrorIs(err, ErrSnapshotArchiveMismatch)
+
+	// truncated
+	_, err = ImportSnapshot(dstDir, "", bytes.NewReader(archive.Bytes()[:archive.Len()/2]))
+	require.Error(err)
+
+	// unsupported format
+	tampered = rewriteArchive(t, archive.Bytes(), snapshotArchiveManifestName, `{"formatVersion":99}`)
+	_, err = ImportSnapshot(dstDir, "", bytes.NewReader(tampered))
+	require.ErrorIs(err, ErrInvalidSnapshotArchive)
+
+	// nothing is registered on failure
+	entries, err := os.ReadDir(dstDir)
+	require.NoError(err)
+	require.Empty(entries)
+}
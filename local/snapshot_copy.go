@@ -0,0 +1,208 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"go.uber.org/zap"
+)
+
+// Content-addressed store, in the snapshots dir, of the DB files shared
+// between snapshots through hardlinks
+const snapshotObjectsDir = ".objects"
+
+// Extensions of the DB files that are never modified once written, so they
+// can be shared through hardlinks: leveldb tables and pebble sstables.
+// Other DB files, like logs and manifests, are appended to in place.
+var immutableDBFileExts = []string{".ldb", ".sst"}
+
+var errReflinkUnsupported = errors.New("reflinks not supported")
+
+// dbCopier copies node DB dirs to and from snapshots without duplicating
+// their contents where possible. Files are reflinked if the filesystem
+// supports it. Otherwise, immutable files are hardlinked, and the rest is
+// copied.
+type dbCopier struct {
+	log logging.Logger
+	// If not empty, immutable files are hardlinked through this
+	// content-addressed store, so that equal files are shared even if they
+	// were not linked before. Otherwise they are hardlinked to their source.
+	objectsDir string
+	// Set once a reflink fails, so it is not tried again
+	noReflink bool
+
+	reflinked, linked, copied int
+}
+
+func newDBCopier(log logging.Logger, objectsDir string) *dbCopier {
+	return &dbCopier{log: log, objectsDir: objectsDir}
+}
+
+// Copies [srcDir] into [dstDir].
+func (c *dbCopier) copyDir(srcDir string, dstDir string) error {
+	err := filepath.WalkDir(srcDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dstDir, relPath)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(target, dstPath)
+		case d.Type().IsRegular():
+			return c.copyFile(path, dstPath, info.Mode().Perm())
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+	c.log.Debug("copied db dir",
+		zap.String("src", srcDir),
+		zap.String("dst", dstDir),
+		zap.Int("reflinked", c.reflinked),
+		zap.Int("hardlinked", c.linked),
+		zap.Int("copied", c.copied),
+	)
+	return nil
+}
+
+func (c *dbCopier) copyFile(src string, dst string, perm fs.FileMode) error {
+	if !c.noReflink {
+		err := reflinkFile(src, dst, perm)
+		if err == nil {
+			c.reflinked++
+			return nil
+		}
+		if !errors.Is(err, errReflinkUnsupported) {
+			return err
+		}
+		c.log.Debug("falling back to hardlinks", zap.Error(err))
+		c.noReflink = true
+	}
+	if isImmutableDBFile(src) {
+		err := c.linkFile(src, dst)
+		if err == nil {
+			c.linked++
+			return nil
+		}
+		c.log.Debug("falling back to copy", zap.String("file", src), zap.Error(err))
+	}
+	c.copied++
+	return copyFile(src, dst, perm)
+}
+
+// Hardlinks [dst] to [src], through the objects store if there is one.
+func (c *dbCopier) linkFile(src string, dst string) error {
+	if c.objectsDir == "" {
+		return os.Link(src, dst)
+	}
+	_, sum, err := hashFile(src)
+	if err != nil {
+		return err
+	}
+	objectPath := filepath.Join(c.objectsDir, sum[:2], sum)
+	if _, err := os.Stat(objectPath); errors.Is(err, os.ErrNotExist) {
+		if err := addSnapshotObject(src, objectPath); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	return os.Link(objectPath, dst)
+}
+
+// Adds [src] to the objects store as [objectPath], hardlinking it if they
+// are on the same filesystem.
+func addSnapshotObject(src string, objectPath string) error {
+	if err := os.MkdirAll(filepath.Dir(objectPath), os.ModePerm); err != nil {
+		return err
+	}
+	err := os.Link(src, objectPath)
+	if err == nil || errors.Is(err, os.ErrExist) {
+		return nil
+	}
+	// copied under a temp name, so an interrupted copy is not taken as an object
+	tmpPath := objectPath + ".tmp"
+	if err := copyFile(src, tmpPath, 0o644); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, objectPath)
+}
+
+// Removes the objects of [snapshotsDir] no longer linked from any snapshot
+// or network.
+func pruneSnapshotObjects(snapshotsDir string) error {
+	objectsDir := filepath.Join(snapshotsDir, snapshotObjectsDir)
+	err := filepath.WalkDir(objectsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok && stat.Nlink > 1 {
+			return nil
+		}
+		return os.Remove(path)
+	})
+	if err != nil {
+		return fmt.Errorf("failure pruning snapshot objects: %w", err)
+	}
+	return nil
+}
+
+func isImmutableDBFile(path string) bool {
+	for _, ext := range immutableDBFileExts {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func copyFile(src string, dst string, perm fs.FileMode) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+	dstFile, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		_ = dstFile.Close()
+		return err
+	}
+	return dstFile.Close()
+}
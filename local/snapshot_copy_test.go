@@ -0,0 +1,114 @@
+package local
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+var testDBFiles = map[string]string{
+	"000005.ldb":      "table",
+	"v/000006.sst":    "sstable",
+	"000007.log":      "log",
+	"MANIFEST-000004": "manifest",
+}
+
+func newTestDBDir(t *testing.T) string {
+	dir := t.TempDir()
+	for filePath, content := range testDBFiles {
+		filePath = filepath.Join(dir, filePath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), os.ModePerm))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+	return dir
+}
+
+func sameFile(t *testing.T, path1 string, path2 string) bool {
+	info1, err := os.Stat(path1)
+	require.NoError(t, err)
+	info2, err := os.Stat(path2)
+	require.NoError(t, err)
+	return os.SameFile(info1, info2)
+}
+
+func TestDBCopierHardlinks(t *testing.T) {
+	require := require.New(t)
+
+	srcDir := newTestDBDir(t)
+	snapshotsDir := t.TempDir()
+	objectsDir := filepath.Join(snapshotsDir, snapshotObjectsDir)
+
+	// two snapshots of the same db share the immutable files
+	dstDirs := []string{filepath.Join(snapshotsDir, "snap1"), filepath.Join(snapshotsDir, "snap2")}
+	for _, dstDir := range dstDirs {
+		copier := newDBCopier(logging.NoLog{}, objectsDir)
+		copier.noReflink = true
+		require.NoError(copier.copyDir(srcDir, dstDir))
+		require.Equal(2, copier.linked)
+		require.Equal(2, copier.copied)
+		for filePath, content := range testDBFiles {
+			data, err := os.ReadFile(filepath.Join(dstDir, filePath))
+			require.NoError(err)
+			require.Equal(content, string(data))
+		}
+	}
+	for _, filePath := range []string{"000005.ldb", "v/000006.sst"} {
+		require.True(sameFile(t, filepath.Join(dstDirs[0], filePath), filepath.Join(dstDirs[1], filePath)))
+	}
+	// mutable files are not shared
+	require.False(sameFile(t, filepath.Join(dstDirs[0], "000007.log"), filepath.Join(dstDirs[1], "000007.log")))
+	require.NoError(os.WriteFile(filepath.Join(srcDir, "000007.log"), []byte("log appended"), 0o600))
+	data, err := os.ReadFile(filepath.Join(dstDirs[0], "000007.log"))
+	require.NoError(err)
+	require.Equal("log", string(data))
+
+	// a network loaded from a snapshot shares its immutable files
+	loadDir := filepath.Join(t.TempDir(), "db")
+	copier := newDBCopier(logging.NoLog{}, "")
+	copier.noReflink = true
+	require.NoError(copier.copyDir(dstDirs[0], loadDir))
+	require.True(sameFile(t, filepath.Join(dstDirs[0], "000005.ldb"), filepath.Join(loadDir, "000005.ldb")))
+
+	// objects are kept while linked
+	require.NoError(os.RemoveAll(dstDirs[0]))
+	require.NoError(os.RemoveAll(dstDirs[1]))
+	require.NoError(os.RemoveAll(srcDir))
+	require.NoError(pruneSnapshotObjects(snapshotsDir))
+	objects, err := filepath.Glob(filepath.Join(objectsDir, "*", "*"))
+	require.NoError(err)
+	require.Len(objects, 2)
+	require.NoError(os.RemoveAll(loadDir))
+	require.NoError(pruneSnapshotObjects(snapshotsDir))
+	objects, err = filepath.Glob(filepath.Join(objectsDir, "*", "*"))
+	require.NoError(err)
+	require.Empty(objects)
+}
+
+func TestDBCopierReflinks(t *testing.T) {
+	require := require.New(t)
+
+	srcDir := newTestDBDir(t)
+	dstDir := filepath.Join(t.TempDir(), "db")
+	copier := newDBCopier(logging.NoLog{}, "")
+	require.NoError(copier.copyDir(srcDir, dstDir))
+	for filePath, content := range testDBFiles {
+		data, err := os.ReadFile(filepath.Join(dstDir, filePath))
+		require.NoError(err)
+		require.Equal(content, string(data))
+	}
+	if copier.noReflink {
+		err := reflinkFile(filepath.Join(srcDir, "000007.log"), filepath.Join(t.TempDir(), "log"), 0o600)
+		require.True(errors.Is(err, errReflinkUnsupported))
+		t.Skip("reflinks not supported by the filesystem")
+	}
+	require.Equal(len(testDBFiles), copier.reflinked)
+	// reflinked files are independent copies
+	require.NoError(os.WriteFile(filepath.Join(srcDir, "000007.log"), []byte("log appended"), 0o600))
+	data, err := os.ReadFile(filepath.Join(dstDir, "000007.log"))
+	require.NoError(err)
+	require.Equal("log", string(data))
+}
@@ -0,0 +1,328 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odyssey-network-runner/utils"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const snapshotMetadataName = "metadata.json"
+
+// SnapshotMetadata describes a snapshot, so it can be chosen without
+// loading it.
+type SnapshotMetadata struct {
+	// Set from the snapshot dir on read
+	SnapshotName string `json:"-"`
+	// Version of the snapshot layout. Zero for snapshots saved before it
+	// was recorded
+	FormatVersion uint32            `json:"formatVersion"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	Description   string            `json:"description"`
+	Labels        map[string]string `json:"labels"`
+	// Empty if unknown
+	OdysseyGoVersion string `json:"odysseygoVersion"`
+	// Version of the DB of the OdysseyGo binary. Empty if unknown
+	DatabaseVersion string `json:"databaseVersion,omitempty"`
+	// Versions of the VMs of the nodes, by VM alias. Empty if unknown
+	VMVersions map[string]string `json:"vmVersions,omitempty"`
+	NumNodes   int               `json:"numNodes"`
+	// Subnets other than the primary network
+	Subnets []SnapshotSubnet `json:"subnets"`
+	// Total size of the node DBs, in bytes
+	DBSize int64 `json:"dbSize"`
+}
+
+type SnapshotSubnet struct {
+	ID string `json:"id"`
+	// Empty if the subnet is not elastic
+	ElasticSubnetID string               `json:"elasticSubnetID,omitempty"`
+	Blockchains     []SnapshotBlockchain `json:"blockchains"`
+}
+
+type SnapshotBlockchain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	VMID string `json:"vmID"`
+	// Empty if the VM ID was not derived from a name
+	VMName string `json:"vmName"`
+}
+
+// HasLabels returns true if the snapshot has all the [labels].
+func (m *SnapshotMetadata) HasLabels(labels map[string]string) bool {
+	for k, v := range labels {
+		if label, ok := m.Labels[k]; !ok || label != v {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSnapshotMetadata returns the metadata of the snapshot [snapshotName]
+// of [snapshotsDir]. For snapshots saved without it, the metadata is
+// derived from the snapshot files, so it lacks the chains and labels.
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func GetSnapshotMetadata(snapshotsDir string, snapshotName string) (*SnapshotMetadata, error) {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return nil, err
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	if _, err := os.Stat(filepath.Join(snapshotDir, snapshotNetworkConfigName)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
+	}
+
+	metadataJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotMetadataName))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		metadata, err := deriveSnapshotMetadata(snapshotDir)
+		if err != nil {
+			return nil, fmt.Errorf("failure reading snapshot %q: %w", snapshotName, err)
+		}
+		metadata.SnapshotName = snapshotName
+		return metadata, nil
+	}
+	metadata := &SnapshotMetadata{}
+	if err := json.Unmarshal(metadataJSON, metadata); err != nil {
+		return nil, fmt.Errorf("failure reading snapshot %q metadata: %w", snapshotName, err)
+	}
+	metadata.SnapshotName = snapshotName
+	return metadata, nil
+}
+
+// ListSnapshotMetadata returns the metadata of the snapshots of [store]
+// that have all the [labels], sorted by name.
+func ListSnapshotMetadata(ctx context.Context, store SnapshotStore, labels map[string]string) ([]*SnapshotMetadata, error) {
+	snapshotNames, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(snapshotNames)
+	snapshots := []*SnapshotMetadata{}
+	for _, snapshotName := range snapshotNames {
+		metadata, err := store.GetMetadata(ctx, snapshotName)
+		if err != nil {
+			return nil, err
+		}
+		if metadata.HasLabels(labels) {
+			snapshots = append(snapshots, metadata)
+		}
+	}
+	return snapshots, nil
+}
+
+// Returns the metadata of a snapshot saved without it.
+func deriveSnapshotMetadata(snapshotDir string) (*SnapshotMetadata, error) {
+	networkConfigJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotNetworkConfigName))
+	if err != nil {
+		return nil, err
+	}
+	networkConfig := network.Config{}
+	if err := json.Unmarshal(networkConfigJSON, &networkConfig); err != nil {
+		return nil, err
+	}
+	networkState := NetworkState{}
+	networkStateJSON, err := os.ReadFile(filepath.Join(snapshotDir, "state.json"))
+	if err == nil {
+		if err := json.Unmarshal(networkStateJSON, &networkState); err != nil {
+			return nil, err
+		}
+	}
+	info, err := os.Stat(filepath.Join(snapshotDir, snapshotNetworkConfigName))
+	if err != nil {
+		return nil, err
+	}
+	dbSize, err := getDirSize(filepath.Join(snapshotDir, defaultDBSubdir))
+	if err != nil {
+		return nil, err
+	}
+	subnets := []SnapshotSubnet{}
+	for subnetID, elasticSubnetID := range networkState.SubnetID2ElasticSubnetID {
+		subnets = append(subnets, SnapshotSubnet{
+			ID:              subnetID,
+			ElasticSubnetID: elasticSubnetID,
+			Blockchains:     []SnapshotBlockchain{},
+		})
+	}
+	sortSnapshotSubnets(subnets)
+	return &SnapshotMetadata{
+		CreatedAt:        info.ModTime().UTC(),
+		Labels:           map[string]string{},
+		OdysseyGoVersion: getSnapshotNodeVersion(snapshotDir),
+		NumNodes:         len(networkConfig.NodeConfigs),
+		Subnets:          subnets,
+		DBSize:           dbSize,
+	}, nil
+}
+
+// Completes [metadata] with the info of the saved snapshot dir [snapshotDir],
+// and writes it.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) writeSnapshotMetadata(
+	snapshotDir string,
+	nodesConfig map[string]node.Config,
+	metadata *SnapshotMetadata,
+) error {
+	dbSize, err := getDirSize(filepath.Join(snapshotDir, defaultDBSubdir))
+	if err != nil {
+		return err
+	}
+	nodeConfig := node.Config{BinaryPath: ln.binaryPath}
+	nodeNames := maps.Keys(nodesConfig)
+	sort.Strings(nodeNames)
+	if len(nodeNames) > 0 && nodesConfig[nodeNames[0]].BinaryPath != "" {
+		nodeConfig = nodesConfig[nodeNames[0]]
+	}
+	if nodeVersionOutput, err := ln.nodeProcessCreator.GetNodeVersion(nodeConfig); err == nil {
+		metadata.DatabaseVersion = parseNodeDBVersion(nodeVersionOutput)
+		if odysseyGoVersion, err := parseNodeSemVer(nodeVersionOutput, nodeConfig.BinaryPath); err == nil {
+			metadata.OdysseyGoVersion = odysseyGoVersion
+		} else {
+			ln.log.Warn("couldn't get node version for snapshot metadata", zap.Error(err))
+		}
+	} else {
+		ln.log.Warn("couldn't get node version for snapshot metadata", zap.Error(err))
+	}
+	if metadata.Labels == nil {
+		metadata.Labels = map[string]string{}
+	}
+	metadata.FormatVersion = SnapshotFormatVersion
+	metadata.CreatedAt = time.Now().UTC()
+	metadata.NumNodes = len(nodesConfig)
+	metadata.DBSize = dbSize
+	metadataJSON, err := json.MarshalIndent(metadata, "", "    ")
+	if err != nil {
+		return err
+	}
+	return createFileAndWrite(filepath.Join(snapshotDir, snapshotMetadataName), metadataJSON)
+}
+
+// Returns the first running node of the network, by name, or nil if no
+// node is running.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getFirstRunningNode() *localNode {
+	nodeNames := maps.Keys(ln.nodes)
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		if !ln.nodes[nodeName].paused {
+			return ln.nodes[nodeName]
+		}
+	}
+	return nil
+}
+
+// Returns the versions of the VMs, by VM alias, as seen by the first running
+// node. Returns an empty map if no node is running.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getSnapshotVMVersions(ctx context.Context) (map[string]string, error) {
+	runningNode := ln.getFirstRunningNode()
+	if runningNode == nil {
+		return map[string]string{}, nil
+	}
+	reply, err := runningNode.client.InfoAPI().GetNodeVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return reply.VMVersions, nil
+}
+
+// Returns the subnets of the network, other than the primary network, along
+// with their blockchains, as seen by the first running node.
+// Returns an empty list if no node is running.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) getSnapshotSubnets(ctx context.Context) ([]SnapshotSubnet, error) {
+	runningNode := ln.getFirstRunningNode()
+	if runningNode == nil {
+		return []SnapshotSubnet{}, nil
+	}
+
+	subnets, err := runningNode.client.OChainAPI().GetSubnets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	blockchains, err := runningNode.client.OChainAPI().GetBlockchains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshotSubnets := map[ids.ID]*SnapshotSubnet{}
+	for _, subnet := range subnets {
+		if subnet.ID == constants.PrimaryNetworkID {
+			continue
+		}
+		snapshotSubnet := &SnapshotSubnet{
+			ID:          subnet.ID.String(),
+			Blockchains: []SnapshotBlockchain{},
+		}
+		if elasticSubnetID, ok := ln.subnetID2ElasticSubnetID[subnet.ID]; ok {
+			snapshotSubnet.ElasticSubnetID = elasticSubnetID.String()
+		}
+		snapshotSubnets[subnet.ID] = snapshotSubnet
+	}
+	for _, blockchain := range blockchains {
+		snapshotSubnet, ok := snapshotSubnets[blockchain.SubnetID]
+		if !ok {
+			continue
+		}
+		snapshotSubnet.Blockchains = append(snapshotSubnet.Blockchains, SnapshotBlockchain{
+			ID:     blockchain.ID.String(),
+			Name:   blockchain.Name,
+			VMID:   blockchain.VMID.String(),
+			VMName: utils.VMName(blockchain.VMID),
+		})
+	}
+	sortedSubnets := []SnapshotSubnet{}
+	for _, snapshotSubnet := range snapshotSubnets {
+		sort.Slice(snapshotSubnet.Blockchains, func(i, j int) bool {
+			return snapshotSubnet.Blockchains[i].ID < snapshotSubnet.Blockchains[j].ID
+		})
+		sortedSubnets = append(sortedSubnets, *snapshotSubnet)
+	}
+	sortSnapshotSubnets(sortedSubnets)
+	return sortedSubnets, nil
+}
+
+func sortSnapshotSubnets(subnets []SnapshotSubnet) {
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].ID < subnets[j].ID
+	})
+}
+
+// Returns the total size of the files under [dir], zero if it doesn't exist.
+func getDirSize(dir string) (int64, error) {
+	size := int64(0)
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
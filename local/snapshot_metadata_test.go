@@ -0,0 +1,34 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Snapshots saved before metadata was stored get it from their files
+func TestGetSnapshotMetadataDerived(t *testing.T) {
+	require := require.New(t)
+
+	snapshotsDir := t.TempDir()
+	newTestSnapshot(t, snapshotsDir, "snap")
+	stateJSON := []byte(`{"subnetID2ElasticSubnetID":{"subnet":"elastic"}}`)
+	require.NoError(os.WriteFile(filepath.Join(snapshotsDir, snapshotPrefix+"snap", "state.json"), stateJSON, 0o600))
+
+	metadata, err := GetSnapshotMetadata(snapshotsDir, "snap")
+	require.NoError(err)
+	require.Equal("snap", metadata.SnapshotName)
+	require.Equal(int64(len("node1 db")+len("node2 db")), metadata.DBSize)
+	require.False(metadata.CreatedAt.IsZero())
+	require.Empty(metadata.OdysseyGoVersion)
+	require.Equal([]SnapshotSubnet{{ID: "subnet", ElasticSubnetID: "elastic", Blockchains: []SnapshotBlockchain{}}}, metadata.Subnets)
+	require.True(metadata.HasLabels(nil))
+	require.False(metadata.HasLabels(map[string]string{"fixture": "elastic"}))
+
+	_, err = GetSnapshotMetadata(snapshotsDir, "missing")
+	require.ErrorIs(err, ErrSnapshotNotFound)
+	_, err = GetSnapshotMetadata(snapshotsDir, "../snap")
+	require.Error(err)
+}
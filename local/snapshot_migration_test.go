@@ -0,0 +1,135 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/api"
+	apimocks "github.com/DioneProtocol/odyssey-network-runner/api/mocks"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/config"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotMigrationsVersion(t *testing.T) {
+	require.Len(t, snapshotMigrations, SnapshotFormatVersion)
+}
+
+func TestMigrateSnapshot(t *testing.T) {
+	ctx := context.Background()
+	snapshotsDir := t.TempDir()
+	newAPIClient := func(host string, port uint16) api.Client {
+		client := newMockAPISuccessful(host, port).(*apimocks.Client)
+		client.On("OChainAPI").Return(&fakeOChainClient{})
+		return client
+	}
+
+	net, err := newNetwork(logging.NoLog{}, newAPIClient, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, net.loadConfig(ctx, testNetworkConfig(t)))
+	require.NoError(t, awaitNetworkHealthy(net, defaultHealthyTimeout))
+	for _, node := range net.nodes {
+		require.NoError(t, os.MkdirAll(filepath.Join(node.GetDbDir(), constants.NetworkName(net.networkID)), os.ModePerm))
+	}
+	snapshotDir, err := net.SaveSnapshot(ctx, "snapshot", "", nil)
+	require.NoError(t, err)
+	stopCtx, stopCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer stopCtxCancel()
+	require.NoError(t, net.Stop(stopCtx))
+
+	// current snapshots need no migration
+	metadata, err := GetSnapshotMetadata(snapshotsDir, "snapshot")
+	require.NoError(t, err)
+	require.Equal(t, uint32(SnapshotFormatVersion), metadata.FormatVersion)
+	migration, err := MigrateSnapshot(snapshotsDir, "snapshot", false)
+	require.NoError(t, err)
+	require.Equal(t, uint32(SnapshotFormatVersion), migration.FromVersion)
+	require.Empty(t, migration.Applied)
+
+	// turn it into a snapshot of an older runner
+	networkConfigPath := filepath.Join(snapshotDir, snapshotNetworkConfigName)
+	networkConfigJSON, err := os.ReadFile(networkConfigPath)
+	require.NoError(t, err)
+	networkConfig := network.Config{}
+	require.NoError(t, json.Unmarshal(networkConfigJSON, &networkConfig))
+	networkConfig.Flags[deprecatedWhitelistedSubnetsKey] = "subnet"
+	networkConfig.NodeConfigs[0].Flags[deprecatedBuildDirKey] = "build"
+	networkConfigJSON, err = json.Marshal(networkConfig)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(networkConfigPath, networkConfigJSON, 0o600))
+	require.NoError(t, os.Remove(filepath.Join(snapshotDir, snapshotMetadataName)))
+	require.NoError(t, os.Remove(filepath.Join(snapshotDir, snapshotChecksumsName)))
+
+	t.Run("dry run", func(t *testing.T) {
+		require := require.New(t)
+
+		migration, err := MigrateSnapshot(snapshotsDir, "snapshot", true)
+		require.NoError(err)
+		require.Equal(uint32(0), migration.FromVersion)
+		require.Equal(uint32(SnapshotFormatVersion), migration.ToVersion)
+		require.Len(migration.Applied, SnapshotFormatVersion)
+		require.NoFileExists(filepath.Join(snapshotDir, snapshotMetadataName))
+		data, err := os.ReadFile(networkConfigPath)
+		require.NoError(err)
+		require.Equal(networkConfigJSON, data)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		require := require.New(t)
+
+		migration, err := MigrateSnapshot(snapshotsDir, "snapshot", false)
+		require.NoError(err)
+		require.Equal(uint32(0), migration.FromVersion)
+		require.Len(migration.Applied, SnapshotFormatVersion)
+		metadata, err := GetSnapshotMetadata(snapshotsDir, "snapshot")
+		require.NoError(err)
+		require.Equal(uint32(SnapshotFormatVersion), metadata.FormatVersion)
+		require.Equal(3, metadata.NumNodes)
+		networkConfigJSON, err := os.ReadFile(networkConfigPath)
+		require.NoError(err)
+		networkConfig := network.Config{}
+		require.NoError(json.Unmarshal(networkConfigJSON, &networkConfig))
+		require.NotContains(networkConfig.Flags, deprecatedWhitelistedSubnetsKey)
+		require.Equal("subnet", networkConfig.Flags[config.TrackSubnetsKey])
+		require.NotContains(networkConfig.NodeConfigs[0].Flags, deprecatedBuildDirKey)
+		require.Equal(filepath.Join("build", "plugins"), networkConfig.NodeConfigs[0].Flags[config.PluginDirKey])
+		require.NoError(net.verifySnapshotChecksums(snapshotDir))
+
+		migration, err = MigrateSnapshot(snapshotsDir, "snapshot", false)
+		require.NoError(err)
+		require.Empty(migration.Applied)
+
+		net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+		require.NoError(err)
+		require.NoError(net.loadSnapshot(ctx, "snapshot", "", "", nil, nil, nil, nil, SnapshotTopology{}, false))
+		require.Len(net.nodes, 3)
+		stopCtx, stopCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer stopCtxCancel()
+		require.NoError(net.Stop(stopCtx))
+	})
+
+	t.Run("newer format", func(t *testing.T) {
+		require := require.New(t)
+
+		metadata, err := GetSnapshotMetadata(snapshotsDir, "snapshot")
+		require.NoError(err)
+		metadata.FormatVersion = SnapshotFormatVersion + 1
+		metadataJSON, err := json.Marshal(metadata)
+		require.NoError(err)
+		require.NoError(os.WriteFile(filepath.Join(snapshotDir, snapshotMetadataName), metadataJSON, 0o600))
+
+		_, err = MigrateSnapshot(snapshotsDir, "snapshot", true)
+		require.ErrorIs(err, ErrUnsupportedSnapshotFormat)
+		net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+		require.NoError(err)
+		err = net.loadSnapshot(ctx, "snapshot", "", "", nil, nil, nil, nil, SnapshotTopology{}, false)
+		require.ErrorIs(err, ErrUnsupportedSnapshotFormat)
+		require.Empty(net.nodes)
+	})
+}
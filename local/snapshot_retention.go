@@ -0,0 +1,79 @@
+package local
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// DefaultSnapshotPinLabel is the label that protects a snapshot from
+// retention when set to "true".
+const DefaultSnapshotPinLabel = "pinned"
+
+// SnapshotRetention limits the snapshots kept by a snapshot store.
+// Zero values mean no limit.
+type SnapshotRetention struct {
+	MaxCount int
+	MaxAge   time.Duration
+	// Max total size of the snapshot DBs, as recorded in their metadata
+	MaxTotalSize int64
+	// Snapshots with this label set to "true" are never removed, but still
+	// count towards the limits. Empty if no snapshot is pinned.
+	PinLabel string
+}
+
+// Enabled returns true if [r] limits the snapshots.
+func (r SnapshotRetention) Enabled() bool {
+	return r.MaxCount > 0 || r.MaxAge > 0 || r.MaxTotalSize > 0
+}
+
+// ApplySnapshotRetention removes the oldest snapshots of [store], along with
+// their copies in the local snapshots dir [snapshotsDir], until they fit the
+// [retention] limits. If [dryRun], nothing is removed.
+// Returns the metadata of the removed snapshots, oldest first.
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func ApplySnapshotRetention(
+	ctx context.Context,
+	store SnapshotStore,
+	snapshotsDir string,
+	retention SnapshotRetention,
+	dryRun bool,
+) ([]*SnapshotMetadata, error) {
+	removed := []*SnapshotMetadata{}
+	if !retention.Enabled() {
+		return removed, nil
+	}
+	snapshots, err := ListSnapshotMetadata(ctx, store, nil)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+	count := len(snapshots)
+	totalSize := int64(0)
+	for _, snapshot := range snapshots {
+		totalSize += snapshot.DBSize
+	}
+	now := time.Now()
+	for _, snapshot := range snapshots {
+		if retention.PinLabel != "" && snapshot.Labels[retention.PinLabel] == "true" {
+			continue
+		}
+		expired := retention.MaxAge > 0 && now.Sub(snapshot.CreatedAt) > retention.MaxAge
+		tooMany := retention.MaxCount > 0 && count > retention.MaxCount
+		tooLarge := retention.MaxTotalSize > 0 && totalSize > retention.MaxTotalSize
+		if !expired && !tooMany && !tooLarge {
+			continue
+		}
+		if !dryRun {
+			if err := removeStoredSnapshot(ctx, store, snapshotsDir, snapshot.SnapshotName); err != nil {
+				return removed, err
+			}
+		}
+		count--
+		totalSize -= snapshot.DBSize
+		removed = append(removed, snapshot)
+	}
+	return removed, nil
+}
@@ -0,0 +1,92 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates the snapshot [snapshotName] in [snapshotsDir] with the given
+// metadata
+func newTestSnapshotWithMetadata(t *testing.T, snapshotsDir string, snapshotName string, metadata SnapshotMetadata) {
+	newTestSnapshot(t, snapshotsDir, snapshotName)
+	metadataJSON, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotsDir, snapshotPrefix+snapshotName, snapshotMetadataName), metadataJSON, 0o600))
+}
+
+func TestApplySnapshotRetention(t *testing.T) {
+	now := time.Now()
+	pinned := map[string]string{DefaultSnapshotPinLabel: "true"}
+	tests := []struct {
+		name      string
+		retention SnapshotRetention
+		removed   []string
+	}{
+		{
+			name:      "no limits",
+			retention: SnapshotRetention{PinLabel: DefaultSnapshotPinLabel},
+			removed:   []string{},
+		},
+		{
+			name:      "max count",
+			retention: SnapshotRetention{MaxCount: 2, PinLabel: DefaultSnapshotPinLabel},
+			removed:   []string{"old", "mid"},
+		},
+		{
+			name:      "max age",
+			retention: SnapshotRetention{MaxAge: 90 * time.Minute, PinLabel: DefaultSnapshotPinLabel},
+			removed:   []string{"old"},
+		},
+		{
+			name:      "max total size",
+			retention: SnapshotRetention{MaxTotalSize: 350, PinLabel: DefaultSnapshotPinLabel},
+			removed:   []string{"old"},
+		},
+		{
+			name:      "without pins",
+			retention: SnapshotRetention{MaxCount: 1},
+			removed:   []string{"oldest", "old", "mid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			snapshotsDir := t.TempDir()
+			newTestSnapshotWithMetadata(t, snapshotsDir, "oldest", SnapshotMetadata{CreatedAt: now.Add(-3 * time.Hour), DBSize: 100, Labels: pinned})
+			newTestSnapshotWithMetadata(t, snapshotsDir, "old", SnapshotMetadata{CreatedAt: now.Add(-2 * time.Hour), DBSize: 100})
+			newTestSnapshotWithMetadata(t, snapshotsDir, "mid", SnapshotMetadata{CreatedAt: now.Add(-time.Hour), DBSize: 100})
+			newTestSnapshotWithMetadata(t, snapshotsDir, "new", SnapshotMetadata{CreatedAt: now, DBSize: 100})
+			store := NewFilesystemSnapshotStore(logging.NoLog{}, snapshotsDir)
+
+			// dry runs only report
+			removed, err := ApplySnapshotRetention(context.Background(), store, snapshotsDir, tt.retention, true)
+			require.NoError(err)
+			require.Len(removed, len(tt.removed))
+			snapshotNames, err := store.List(context.Background())
+			require.NoError(err)
+			require.Len(snapshotNames, 4)
+
+			removed, err = ApplySnapshotRetention(context.Background(), store, snapshotsDir, tt.retention, false)
+			require.NoError(err)
+			removedNames := []string{}
+			for _, metadata := range removed {
+				removedNames = append(removedNames, metadata.SnapshotName)
+			}
+			require.Equal(tt.removed, removedNames)
+			snapshotNames, err = store.List(context.Background())
+			require.NoError(err)
+			require.Len(snapshotNames, 4-len(tt.removed))
+			for _, snapshotName := range tt.removed {
+				require.NotContains(snapshotNames, snapshotName)
+			}
+		})
+	}
+}
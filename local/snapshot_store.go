@@ -0,0 +1,191 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+// SnapshotStore keeps the catalog of saved snapshots. A network writes and
+// reads snapshots in its local snapshots dir, and the store persists them,
+// so that they can be shared by several runners.
+type SnapshotStore interface {
+	// Stores the snapshot [snapshotName] of the local snapshots dir
+	// [snapshotsDir], replacing any stored snapshot with the same name.
+	Put(ctx context.Context, snapshotName string, snapshotsDir string) error
+	// Fetches the stored snapshot [snapshotName] into the local snapshots dir
+	// [snapshotsDir], replacing any local snapshot with the same name.
+	// Returns ErrSnapshotNotFound if it is not stored.
+	Get(ctx context.Context, snapshotName string, snapshotsDir string) error
+	// Returns ErrSnapshotNotFound if it is not stored.
+	Remove(ctx context.Context, snapshotName string) error
+	// Returns the names of the stored snapshots, sorted.
+	List(ctx context.Context) ([]string, error)
+	// Returns the metadata of the stored snapshot [snapshotName],
+	// without fetching it.
+	// Returns ErrSnapshotNotFound if it is not stored.
+	GetMetadata(ctx context.Context, snapshotName string) (*SnapshotMetadata, error)
+}
+
+// NewSnapshotStore returns the snapshot store at [storeURL]:
+//   - empty: the local snapshots dir [snapshotsDir] itself
+//   - a path or a file:// URL: a snapshots dir, e.g. on a shared mount
+//   - an s3:// URL: an S3 compatible bucket, see NewS3SnapshotStoreFromURL
+//
+// [snapshotsDir] defaults to the default snapshots dir if empty.
+func NewSnapshotStore(log logging.Logger, storeURL string, snapshotsDir string) (SnapshotStore, error) {
+	if storeURL == "" {
+		if snapshotsDir == "" {
+			snapshotsDir = defaultSnapshotsDir
+		}
+		return NewFilesystemSnapshotStore(log, snapshotsDir), nil
+	}
+	u, err := url.Parse(storeURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid snapshot store %q: %w", storeURL, err)
+	}
+	switch u.Scheme {
+	case "":
+		return NewFilesystemSnapshotStore(log, storeURL), nil
+	case "file":
+		return NewFilesystemSnapshotStore(log, u.Path), nil
+	case "s3":
+		return NewS3SnapshotStoreFromURL(u)
+	default:
+		return nil, fmt.Errorf("unsupported snapshot store scheme %q", u.Scheme)
+	}
+}
+
+// fsSnapshotStore keeps the snapshots in a snapshots dir. When that is the
+// local snapshots dir of the network, there is nothing to transfer.
+type fsSnapshotStore struct {
+	log logging.Logger
+	dir string
+}
+
+// NewFilesystemSnapshotStore returns a store that keeps the snapshots in
+// the snapshots dir [dir], with the same layout as a local one.
+func NewFilesystemSnapshotStore(log logging.Logger, dir string) SnapshotStore {
+	return &fsSnapshotStore{log: log, dir: dir}
+}
+
+func (s *fsSnapshotStore) Put(_ context.Context, snapshotName string, snapshotsDir string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	if s.isLocal(snapshotsDir) {
+		return nil
+	}
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return err
+	}
+	// copied next to its final location, and renamed once complete
+	tmpDir, err := os.MkdirTemp(s.dir, ".put-"+snapshotName+"-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	copier := newDBCopier(s.log, filepath.Join(s.dir, snapshotObjectsDir))
+	if err := copier.copyDir(getSnapshotDir(snapshotsDir, snapshotName), tmpDir); err != nil {
+		return fmt.Errorf("failure storing snapshot %q: %w", snapshotName, err)
+	}
+	if err := os.Chmod(tmpDir, 0o755); err != nil {
+		return err
+	}
+	snapshotDir := getSnapshotDir(s.dir, snapshotName)
+	if err := os.RemoveAll(snapshotDir); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, snapshotDir); err != nil {
+		return fmt.Errorf("failure storing snapshot %q: %w", snapshotName, err)
+	}
+	return nil
+}
+
+func (s *fsSnapshotStore) Get(_ context.Context, snapshotName string, snapshotsDir string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	snapshotDir := getSnapshotDir(s.dir, snapshotName)
+	if _, err := os.Stat(snapshotDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrSnapshotNotFound
+		}
+		return fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
+	}
+	if s.isLocal(snapshotsDir) {
+		return nil
+	}
+	localSnapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	if err := os.RemoveAll(localSnapshotDir); err != nil {
+		return err
+	}
+	// immutable files are hardlinked to the store if on the same filesystem
+	copier := newDBCopier(s.log, "")
+	if err := copier.copyDir(snapshotDir, localSnapshotDir); err != nil {
+		_ = os.RemoveAll(localSnapshotDir)
+		return fmt.Errorf("failure fetching snapshot %q: %w", snapshotName, err)
+	}
+	return nil
+}
+
+func (s *fsSnapshotStore) Remove(_ context.Context, snapshotName string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	snapshotDir := getSnapshotDir(s.dir, snapshotName)
+	if _, err := os.Stat(snapshotDir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrSnapshotNotFound
+		}
+		return fmt.Errorf("failure accessing snapshot %q: %w", snapshotName, err)
+	}
+	if err := os.RemoveAll(snapshotDir); err != nil {
+		return fmt.Errorf("failure removing snapshot path %q: %w", snapshotDir, err)
+	}
+	return pruneSnapshotObjects(s.dir)
+}
+
+func (s *fsSnapshotStore) List(context.Context) ([]string, error) {
+	if _, err := os.Stat(s.dir); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("snapshots dir %q does not exists", s.dir)
+		}
+		return nil, fmt.Errorf("failure accessing snapshots dir %q: %w", s.dir, err)
+	}
+	matches, err := filepath.Glob(filepath.Join(s.dir, snapshotPrefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+	snapshotNames := []string{}
+	for _, match := range matches {
+		snapshotNames = append(snapshotNames, strings.TrimPrefix(filepath.Base(match), snapshotPrefix))
+	}
+	return snapshotNames, nil
+}
+
+func (s *fsSnapshotStore) GetMetadata(_ context.Context, snapshotName string) (*SnapshotMetadata, error) {
+	return GetSnapshotMetadata(s.dir, snapshotName)
+}
+
+// Returns true if [snapshotsDir] is the dir of the store.
+func (s *fsSnapshotStore) isLocal(snapshotsDir string) bool {
+	if snapshotsDir == "" {
+		snapshotsDir = defaultSnapshotsDir
+	}
+	storeDir, err := filepath.Abs(s.dir)
+	if err != nil {
+		return false
+	}
+	localDir, err := filepath.Abs(snapshotsDir)
+	if err != nil {
+		return false
+	}
+	return storeDir == localDir
+}
@@ -0,0 +1,668 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5" //nolint:gosec // only compared with the S3 ETags
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	// sha256 of an empty payload
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var ErrInvalidSnapshotFilePath = errors.New("invalid snapshot file path")
+
+// S3SnapshotStoreConfig configures a snapshot store in a bucket of an S3
+// compatible service, e.g. AWS S3 or MinIO.
+type S3SnapshotStoreConfig struct {
+	// e.g. https://s3.us-east-1.amazonaws.com or http://127.0.0.1:9000
+	// Buckets are addressed by path.
+	Endpoint string
+	// Defaults to us-east-1 if empty
+	Region string
+	Bucket string
+	// Prepended to the keys of the snapshots, e.g. "ci/"
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Only needed for temporary credentials
+	SessionToken string
+	// Defaults to http.DefaultClient if nil
+	HTTPClient *http.Client
+}
+
+// s3SnapshotStore keeps each file of a snapshot dir as an object under
+// <prefix><snapshot name>/. The metadata object is written last and
+// removed first, so a snapshot is only listed once complete.
+// Files are transferred only if they differ from the destination ones.
+type s3SnapshotStore struct {
+	cfg      S3SnapshotStoreConfig
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3SnapshotStore returns a store that keeps the snapshots in the
+// bucket of [cfg].
+func NewS3SnapshotStore(cfg S3SnapshotStoreConfig) (SnapshotStore, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("missing S3 bucket")
+	}
+	if cfg.Region == "" {
+		cfg.Region = defaultS3Region
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", cfg.Endpoint)
+	}
+	cfg.Prefix = strings.TrimPrefix(cfg.Prefix, "/")
+	if cfg.Prefix != "" && !strings.HasSuffix(cfg.Prefix, "/") {
+		cfg.Prefix += "/"
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &s3SnapshotStore{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   client,
+	}, nil
+}
+
+// NewS3SnapshotStoreFromURL returns the store of [u], of the form
+// s3://<bucket>/<prefix>?endpoint=<endpoint>&region=<region>
+// The region defaults to $AWS_REGION, and the credentials are read from
+// $AWS_ACCESS_KEY_ID, $AWS_SECRET_ACCESS_KEY and $AWS_SESSION_TOKEN.
+func NewS3SnapshotStoreFromURL(u *url.URL) (SnapshotStore, error) {
+	region := u.Query().Get("region")
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	return NewS3SnapshotStore(S3SnapshotStoreConfig{
+		Endpoint:        u.Query().Get("endpoint"),
+		Region:          region,
+		Bucket:          u.Host,
+		Prefix:          u.Path,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	})
+}
+
+func (s *s3SnapshotStore) Put(ctx context.Context, snapshotName string, snapshotsDir string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	// read first, so that a snapshot without metadata gets a derived one
+	metadata, err := GetSnapshotMetadata(snapshotsDir, snapshotName)
+	if err != nil {
+		return err
+	}
+	metadataJSON, err := json.MarshalIndent(metadata, "", "    ")
+	if err != nil {
+		return err
+	}
+	snapshotKey := s.snapshotKey(snapshotName)
+	stored, err := s.listObjects(ctx, snapshotKey)
+	if err != nil {
+		return err
+	}
+	if _, ok := stored[snapshotMetadataName]; ok {
+		if err := s.deleteObject(ctx, snapshotKey+snapshotMetadataName); err != nil {
+			return err
+		}
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	files, err := getSnapshotFiles(snapshotDir)
+	if err != nil {
+		return err
+	}
+	for relPath, filePath := range files {
+		if relPath == snapshotMetadataName {
+			continue
+		}
+		object, isStored := stored[relPath]
+		delete(stored, relPath)
+		size, md5Sum, sha256Sum, err := hashFileForUpload(filePath)
+		if err != nil {
+			return err
+		}
+		if isStored && object.Size == size && object.md5() == md5Sum {
+			continue
+		}
+		if err := s.putFile(ctx, snapshotKey+relPath, filePath, size, md5Sum, sha256Sum); err != nil {
+			return fmt.Errorf("failure storing snapshot %q file %q: %w", snapshotName, relPath, err)
+		}
+	}
+	for relPath := range stored {
+		if relPath == snapshotMetadataName {
+			continue
+		}
+		if err := s.deleteObject(ctx, snapshotKey+relPath); err != nil {
+			return err
+		}
+	}
+	return s.putObject(ctx, snapshotKey+snapshotMetadataName, metadataJSON)
+}
+
+func (s *s3SnapshotStore) Get(ctx context.Context, snapshotName string, snapshotsDir string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	snapshotKey := s.snapshotKey(snapshotName)
+	stored, err := s.listObjects(ctx, snapshotKey)
+	if err != nil {
+		return err
+	}
+	if _, ok := stored[snapshotMetadataName]; !ok {
+		return ErrSnapshotNotFound
+	}
+	// the keys come from the bucket, so none may lead out of the snapshot dir
+	for relPath := range stored {
+		if err := checkSnapshotFilePath(relPath); err != nil {
+			return fmt.Errorf("failure fetching snapshot %q: %w", snapshotName, err)
+		}
+	}
+	snapshotDir := getSnapshotDir(snapshotsDir, snapshotName)
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return err
+	}
+	files, err := getSnapshotFiles(snapshotDir)
+	if err != nil {
+		return err
+	}
+	for relPath, object := range stored {
+		filePath := filepath.Join(snapshotDir, filepath.FromSlash(relPath))
+		if _, ok := files[relPath]; ok {
+			delete(files, relPath)
+			size, md5Sum, _, err := hashFileForUpload(filePath)
+			if err != nil {
+				return err
+			}
+			if object.Size == size && object.md5() == md5Sum {
+				continue
+			}
+		}
+		if err := s.getFile(ctx, snapshotKey+relPath, filePath, object); err != nil {
+			return fmt.Errorf("failure fetching snapshot %q file %q: %w", snapshotName, relPath, err)
+		}
+	}
+	for _, filePath := range files {
+		if err := os.Remove(filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *s3SnapshotStore) Remove(ctx context.Context, snapshotName string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	snapshotKey := s.snapshotKey(snapshotName)
+	stored, err := s.listObjects(ctx, snapshotKey)
+	if err != nil {
+		return err
+	}
+	if len(stored) == 0 {
+		return ErrSnapshotNotFound
+	}
+	if _, ok := stored[snapshotMetadataName]; ok {
+		if err := s.deleteObject(ctx, snapshotKey+snapshotMetadataName); err != nil {
+			return err
+		}
+		delete(stored, snapshotMetadataName)
+	}
+	for relPath := range stored {
+		if err := s.deleteObject(ctx, snapshotKey+relPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *s3SnapshotStore) List(ctx context.Context) ([]string, error) {
+	stored, err := s.listObjects(ctx, s.cfg.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	snapshotNames := []string{}
+	for relPath := range stored {
+		snapshotName, fileName, ok := strings.Cut(relPath, "/")
+		if ok && fileName == snapshotMetadataName {
+			snapshotNames = append(snapshotNames, snapshotName)
+		}
+	}
+	sort.Strings(snapshotNames)
+	return snapshotNames, nil
+}
+
+func (s *s3SnapshotStore) GetMetadata(ctx context.Context, snapshotName string) (*SnapshotMetadata, error) {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, http.MethodGet, s.snapshotKey(snapshotName)+snapshotMetadataName, nil, nil, emptyPayloadHash)
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	metadata := &SnapshotMetadata{}
+	if err := json.NewDecoder(resp.Body).Decode(metadata); err != nil {
+		return nil, fmt.Errorf("failure reading snapshot %q metadata: %w", snapshotName, err)
+	}
+	metadata.SnapshotName = snapshotName
+	return metadata, nil
+}
+
+func (s *s3SnapshotStore) snapshotKey(snapshotName string) string {
+	return s.cfg.Prefix + snapshotName + "/"
+}
+
+type s3Object struct {
+	Key  string `xml:"Key"`
+	ETag string `xml:"ETag"`
+	Size int64  `xml:"Size"`
+}
+
+// Returns the hex encoded md5 of the object, or an empty string if the
+// ETag is not one, e.g. for multipart uploads.
+func (o s3Object) md5() string {
+	etag := strings.Trim(o.ETag, `"`)
+	if len(etag) != hex.EncodedLen(md5.Size) {
+		return ""
+	}
+	return etag
+}
+
+type s3ListBucketResult struct {
+	Contents              []s3Object `xml:"Contents"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+type s3Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("S3 request failed with status %d: %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+func isS3NotFound(err error) bool {
+	var s3Err *s3Error
+	return errors.As(err, &s3Err) && s3Err.StatusCode == http.StatusNotFound
+}
+
+// Returns the objects with keys starting with [prefix], by key relative
+// to it.
+func (s *s3SnapshotStore) listObjects(ctx context.Context, prefix string) (map[string]s3Object, error) {
+	objects := map[string]s3Object{}
+	query := url.Values{
+		"list-type": {"2"},
+		"prefix":    {prefix},
+	}
+	for {
+		result, err := func() (*s3ListBucketResult, error) {
+			resp, err := s.do(ctx, http.MethodGet, "", query, nil, emptyPayloadHash)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			result := &s3ListBucketResult{}
+			if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+				return nil, fmt.Errorf("failure decoding S3 object list: %w", err)
+			}
+			return result, nil
+		}()
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Contents {
+			objects[strings.TrimPrefix(object.Key, prefix)] = object
+		}
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+func (s *s3SnapshotStore) putObject(ctx context.Context, key string, data []byte) error {
+	sum := sha256.Sum256(data)
+	resp, err := s.do(ctx, http.MethodPut, key, nil, bytes.NewReader(data), hex.EncodeToString(sum[:]))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *s3SnapshotStore) putFile(
+	ctx context.Context,
+	key string,
+	filePath string,
+	size int64,
+	md5Sum string,
+	sha256Sum string,
+) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	md5Bytes, err := hex.DecodeString(md5Sum)
+	if err != nil {
+		return err
+	}
+	req, err := s.newRequest(ctx, http.MethodPut, key, nil, f, sha256Sum)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	// lets the service reject a file modified during upload
+	req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(md5Bytes))
+	resp, err := s.send(req, sha256Sum)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Downloads [key] into [filePath], replacing it only once complete, so
+// that files hardlinked to it are not modified.
+func (s *s3SnapshotStore) getFile(ctx context.Context, key string, filePath string, object s3Object) error {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(filePath), ".fetch-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	h := md5.New() //nolint:gosec
+	size, err := io.Copy(io.MultiWriter(f, h), resp.Body)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if size != object.Size {
+		return fmt.Errorf("got %d bytes instead of %d", size, object.Size)
+	}
+	if md5Sum := object.md5(); md5Sum != "" && md5Sum != hex.EncodeToString(h.Sum(nil)) {
+		return fmt.Errorf("checksum doesn't match ETag %s", object.ETag)
+	}
+	if err := os.Chmod(f.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filePath)
+}
+
+func (s *s3SnapshotStore) deleteObject(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Sends a signed request for the object [key] of the bucket, or for the
+// bucket itself if empty, with a body of sha256 [payloadHash].
+// The response body must be closed by the caller.
+func (s *s3SnapshotStore) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	body io.Reader,
+	payloadHash string,
+) (*http.Response, error) {
+	req, err := s.newRequest(ctx, method, key, query, body, payloadHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.send(req, payloadHash)
+}
+
+func (s *s3SnapshotStore) newRequest(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	body io.Reader,
+	payloadHash string,
+) (*http.Request, error) {
+	u := *s.endpoint
+	u.Path = "/" + s.cfg.Bucket
+	u.RawPath = "/" + uriEncode(s.cfg.Bucket, true)
+	if key != "" {
+		u.Path += "/" + key
+		u.RawPath += "/" + uriEncode(key, false)
+	}
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	return req, nil
+}
+
+func (s *s3SnapshotStore) send(req *http.Request, payloadHash string) (*http.Response, error) {
+	signV4(req, payloadHash, s.cfg.AccessKeyID, s.cfg.SecretAccessKey, s.cfg.SessionToken, s.cfg.Region, "s3", time.Now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		s3Err := &s3Error{StatusCode: resp.StatusCode}
+		// some errors have no body
+		_ = xml.NewDecoder(resp.Body).Decode(s3Err)
+		return nil, s3Err
+	}
+	return resp, nil
+}
+
+// Signs [req] with AWS signature version 4, as described at
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+// Signs the host, content type and md5, and x-amz-* headers.
+func signV4(
+	req *http.Request,
+	payloadHash string,
+	accessKeyID string,
+	secretAccessKey string,
+	sessionToken string,
+	region string,
+	service string,
+	now time.Time,
+) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || name == "content-md5" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	headerNames := maps.Keys(headers)
+	sort.Strings(headerNames)
+	canonicalHeaders := &strings.Builder{}
+	for _, name := range headerNames {
+		fmt.Fprintf(canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+	canonicalURI := req.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+	signingKey := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{date, region, service, "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// Returns the query of [query] as expected by signature version 4: sorted,
+// and with every reserved character percent encoded.
+func canonicalQuery(query url.Values) string {
+	keys := maps.Keys(query)
+	sort.Strings(keys)
+	params := []string{}
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// Percent encodes every byte of [s] but the unreserved characters, and
+// slashes unless [encodeSlash].
+func uriEncode(s string, encodeSlash bool) string {
+	b := &strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// Returns the regular files of [snapshotDir], by slash separated path
+// relative to it.
+func getSnapshotFiles(snapshotDir string) (map[string]string, error) {
+	files := map[string]string{}
+	err := filepath.WalkDir(snapshotDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		relPath, err := filepath.Rel(snapshotDir, filePath)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relPath)] = filePath
+		return nil
+	})
+	return files, err
+}
+
+// Returns an error if the slash separated [relPath] is not a clean path
+// relative to, and inside of, the snapshot dir.
+func checkSnapshotFilePath(relPath string) error {
+	localPath := filepath.FromSlash(relPath)
+	if relPath == "" ||
+		path.Clean(relPath) != relPath ||
+		path.IsAbs(relPath) ||
+		filepath.IsAbs(localPath) ||
+		filepath.VolumeName(localPath) != "" ||
+		relPath == "." ||
+		relPath == ".." ||
+		strings.HasPrefix(relPath, "../") {
+		return fmt.Errorf("%w %q", ErrInvalidSnapshotFilePath, relPath)
+	}
+	return nil
+}
+
+// Returns the size, and the hex encoded md5 and sha256 of the file at
+// [filePath].
+func hashFileForUpload(filePath string) (int64, string, string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return 0, "", "", err
+	}
+	defer f.Close()
+
+	md5Hash := md5.New() //nolint:gosec
+	sha256Hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(md5Hash, sha256Hash), f)
+	if err != nil {
+		return 0, "", "", err
+	}
+	return size, hex.EncodeToString(md5Hash.Sum(nil)), hex.EncodeToString(sha256Hash.Sum(nil)), nil
+}
@@ -0,0 +1,399 @@
+package local
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/api"
+	apimocks "github.com/DioneProtocol/odyssey-network-runner/api/mocks"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// fakeS3 is an in-process S3 compatible service, with a single bucket kept
+// in memory.
+type fakeS3 struct {
+	t      *testing.T
+	bucket string
+	// Max number of keys per list page
+	pageSize int
+
+	lock    sync.Mutex
+	objects map[string][]byte
+	puts    int
+	gets    int
+}
+
+func newFakeS3(t *testing.T, bucket string) *fakeS3 {
+	return &fakeS3{
+		t:        t,
+		bucket:   bucket,
+		pageSize: 2,
+		objects:  map[string][]byte{},
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		f.writeError(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket)
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key = strings.TrimPrefix(key, "/")
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		f.list(w, r)
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		sha256Sum := sha256.Sum256(data)
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sha256Sum[:]) {
+			f.writeError(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch")
+			return
+		}
+		md5Sum := md5.Sum(data) //nolint:gosec
+		if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" &&
+			contentMD5 != base64.StdEncoding.EncodeToString(md5Sum[:]) {
+			f.writeError(w, http.StatusBadRequest, "BadDigest")
+			return
+		}
+		f.objects[key] = data
+		f.puts++
+		w.Header().Set("ETag", `"`+hex.EncodeToString(md5Sum[:])+`"`)
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			f.writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		f.gets++
+		_, _ = w.Write(data)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	require.Equal(f.t, "2", r.URL.Query().Get("list-type"))
+	prefix := r.URL.Query().Get("prefix")
+	// the token is the last key of the previous page
+	token := r.URL.Query().Get("continuation-token")
+	keys := []string{}
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	result := s3ListBucketResult{}
+	if len(keys) > f.pageSize {
+		keys = keys[:f.pageSize]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		md5Sum := md5.Sum(f.objects[key]) //nolint:gosec
+		result.Contents = append(result.Contents, s3Object{
+			Key:  key,
+			ETag: `"` + hex.EncodeToString(md5Sum[:]) + `"`,
+			Size: int64(len(f.objects[key])),
+		})
+	}
+	require.NoError(f.t, xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"ListBucketResult"`
+		s3ListBucketResult
+	}{s3ListBucketResult: result}))
+}
+
+func (*fakeS3) writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.WriteHeader(statusCode)
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+	}{Code: code})
+}
+
+func (f *fakeS3) objectKeys() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	keys := maps.Keys(f.objects)
+	sort.Strings(keys)
+	return keys
+}
+
+func newTestS3SnapshotStore(t *testing.T) (SnapshotStore, *fakeS3) {
+	fake := newFakeS3(t, "snapshots")
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	store, err := NewS3SnapshotStore(S3SnapshotStoreConfig{
+		Endpoint:        srv.URL,
+		Bucket:          "snapshots",
+		Prefix:          "ci",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+	return store, fake
+}
+
+func requireTestSnapshot(t *testing.T, snapshotsDir string, snapshotName string) {
+	for filePath, content := range testSnapshotFiles {
+		data, err := os.ReadFile(filepath.Join(snapshotsDir, snapshotPrefix+snapshotName, filePath))
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	}
+}
+
+func TestS3SnapshotStore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	store, fake := newTestS3SnapshotStore(t)
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	require.Equal([]string{
+		"ci/snap/db/node1/network-1337/000001.db",
+		"ci/snap/db/node2/network-1337/000001.db",
+		"ci/snap/metadata.json",
+		"ci/snap/network.json",
+		"ci/snap/state.json",
+	}, fake.objectKeys())
+	snapshotNames, err := store.List(ctx)
+	require.NoError(err)
+	require.Equal([]string{"snap"}, snapshotNames)
+	metadata, err := store.GetMetadata(ctx, "snap")
+	require.NoError(err)
+	require.Equal("snap", metadata.SnapshotName)
+	require.Equal(int64(len("node1 db")+len("node2 db")), metadata.DBSize)
+
+	// only the metadata is written again
+	puts := fake.puts
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	require.Equal(puts+1, fake.puts)
+
+	dstDir := t.TempDir()
+	require.NoError(store.Get(ctx, "snap", dstDir))
+	requireTestSnapshot(t, dstDir, "snap")
+	require.FileExists(filepath.Join(dstDir, snapshotPrefix+"snap", snapshotMetadataName))
+
+	// only the files that differ are fetched again
+	snapshotDir := filepath.Join(dstDir, snapshotPrefix+"snap")
+	require.NoError(os.WriteFile(filepath.Join(snapshotDir, "state.json"), []byte(`{"changed":true}`), 0o600))
+	require.NoError(os.WriteFile(filepath.Join(snapshotDir, "extra.json"), []byte(`{}`), 0o600))
+	gets := fake.gets
+	require.NoError(store.Get(ctx, "snap", dstDir))
+	require.Equal(gets+1, fake.gets)
+	requireTestSnapshot(t, dstDir, "snap")
+	require.NoFileExists(filepath.Join(snapshotDir, "extra.json"))
+
+	require.ErrorIs(store.Get(ctx, "missing", dstDir), ErrSnapshotNotFound)
+	_, err = store.GetMetadata(ctx, "missing")
+	require.ErrorIs(err, ErrSnapshotNotFound)
+
+	require.NoError(store.Remove(ctx, "snap"))
+	require.Empty(fake.objectKeys())
+	snapshotNames, err = store.List(ctx)
+	require.NoError(err)
+	require.Empty(snapshotNames)
+	require.ErrorIs(store.Remove(ctx, "snap"), ErrSnapshotNotFound)
+}
+
+// Snapshots without metadata are not complete, so they are not listed
+func TestS3SnapshotStoreIncomplete(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	store, fake := newTestS3SnapshotStore(t)
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	fake.lock.Lock()
+	delete(fake.objects, "ci/snap/metadata.json")
+	fake.lock.Unlock()
+
+	snapshotNames, err := store.List(ctx)
+	require.NoError(err)
+	require.Empty(snapshotNames)
+	require.ErrorIs(store.Get(ctx, "snap", t.TempDir()), ErrSnapshotNotFound)
+	require.NoError(store.Remove(ctx, "snap"))
+	require.Empty(fake.objectKeys())
+}
+
+// Keys of the bucket can't lead out of the snapshot dir
+func TestS3SnapshotStorePathTraversal(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	store, fake := newTestS3SnapshotStore(t)
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	fake.lock.Lock()
+	fake.objects["ci/snap/../../escaped.json"] = []byte(`{}`)
+	fake.lock.Unlock()
+
+	rootDir := t.TempDir()
+	dstDir := filepath.Join(rootDir, "snapshots")
+	require.ErrorIs(store.Get(ctx, "snap", dstDir), ErrInvalidSnapshotFilePath)
+	require.NoDirExists(dstDir)
+	require.NoFileExists(filepath.Join(rootDir, "escaped.json"))
+}
+
+func TestCheckSnapshotFilePath(t *testing.T) {
+	for _, relPath := range []string{"state.json", "db/node1/network-1337/000001.db", "..data"} {
+		require.NoError(t, checkSnapshotFilePath(relPath), relPath)
+	}
+	for _, relPath := range []string{"", ".", "..", "../x", "db/../../x", "/etc/passwd", "db//x", "db/"} {
+		require.ErrorIs(t, checkSnapshotFilePath(relPath), ErrInvalidSnapshotFilePath, relPath)
+	}
+}
+
+func TestFilesystemSnapshotStore(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	srcDir := t.TempDir()
+	newTestSnapshot(t, srcDir, "snap")
+	store := NewFilesystemSnapshotStore(logging.NoLog{}, t.TempDir())
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	snapshotNames, err := store.List(ctx)
+	require.NoError(err)
+	require.Equal([]string{"snap"}, snapshotNames)
+	metadata, err := store.GetMetadata(ctx, "snap")
+	require.NoError(err)
+	require.Equal("snap", metadata.SnapshotName)
+
+	dstDir := t.TempDir()
+	require.NoError(store.Get(ctx, "snap", dstDir))
+	requireTestSnapshot(t, dstDir, "snap")
+
+	require.NoError(store.Remove(ctx, "snap"))
+	snapshotNames, err = store.List(ctx)
+	require.NoError(err)
+	require.Empty(snapshotNames)
+	require.ErrorIs(store.Get(ctx, "snap", dstDir), ErrSnapshotNotFound)
+	require.ErrorIs(store.Remove(ctx, "snap"), ErrSnapshotNotFound)
+
+	// a store on the local snapshots dir has nothing to transfer
+	store = NewFilesystemSnapshotStore(logging.NoLog{}, srcDir)
+	require.NoError(store.Put(ctx, "snap", srcDir))
+	require.NoError(store.Get(ctx, "snap", srcDir))
+	requireTestSnapshot(t, srcDir, "snap")
+}
+
+// Snapshots saved by a network can be loaded by another one, with its own
+// snapshots dir, through the store
+func TestSnapshotStoreSaveAndLoad(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	store, fake := newTestS3SnapshotStore(t)
+	newAPIClient := func(host string, port uint16) api.Client {
+		client := newMockAPISuccessful(host, port).(*apimocks.Client)
+		client.On("OChainAPI").Return(&fakeOChainClient{})
+		return client
+	}
+
+	net, err := newNetwork(logging.NoLog{}, newAPIClient, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), t.TempDir(), store, false)
+	require.NoError(err)
+	require.NoError(net.loadConfig(ctx, testNetworkConfig(t)))
+	require.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+	networkID := net.networkID
+	for _, node := range net.nodes {
+		dbDir := filepath.Join(node.GetDbDir(), constants.NetworkName(networkID))
+		require.NoError(os.MkdirAll(dbDir, os.ModePerm))
+		require.NoError(os.WriteFile(filepath.Join(dbDir, "000001.ldb"), []byte(node.GetName()), 0o600))
+	}
+	_, err = net.SaveSnapshot(ctx, "snapshot", "", nil)
+	require.NoError(err)
+	require.Contains(fake.objectKeys(), "ci/snapshot/metadata.json")
+
+	rootDir := t.TempDir()
+	net, err = newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, rootDir, t.TempDir(), store, false)
+	require.NoError(err)
+	snapshotNames, err := net.GetSnapshotNames()
+	require.NoError(err)
+	require.Equal([]string{"snapshot"}, snapshotNames)
+	_, err = net.SaveSnapshot(ctx, "snapshot", "", nil)
+	require.ErrorContains(err, "already exists")
+
+	require.NoError(net.loadSnapshot(ctx, "snapshot", "", "", nil, nil, nil, nil, SnapshotTopology{}, false))
+	require.Len(net.nodes, 3)
+	for nodeName := range net.nodes {
+		data, err := os.ReadFile(filepath.Join(rootDir, nodeName, defaultDBSubdir, constants.NetworkName(networkID), "000001.ldb"))
+		require.NoError(err)
+		require.Equal(nodeName, string(data))
+	}
+	stopCtx, stopCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer stopCtxCancel()
+	require.NoError(net.Stop(stopCtx))
+
+	require.NoError(net.RemoveSnapshot("snapshot"))
+	require.Empty(fake.objectKeys())
+	require.NoDirExists(filepath.Join(net.snapshotsDir, snapshotPrefix+"snapshot"))
+	require.ErrorIs(net.RemoveSnapshot("snapshot"), ErrSnapshotNotFound)
+}
+
+// Example from https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+func TestSignV4(t *testing.T) {
+	require := require.New(t)
+
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	signV4(req, emptyPayloadHash, "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "", "us-east-1", "iam", now)
+	require.Equal("20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	require.Equal(
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-date, "+
+			"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7",
+		req.Header.Get("Authorization"),
+	)
+}
+
+func TestNewSnapshotStore(t *testing.T) {
+	require := require.New(t)
+
+	store, err := NewSnapshotStore(logging.NoLog{}, "", "/snapshots")
+	require.NoError(err)
+	require.Equal("/snapshots", store.(*fsSnapshotStore).dir)
+	store, err = NewSnapshotStore(logging.NoLog{}, "file:///shared/snapshots", "")
+	require.NoError(err)
+	require.Equal("/shared/snapshots", store.(*fsSnapshotStore).dir)
+	store, err = NewSnapshotStore(logging.NoLog{}, "s3://bucket/ci?endpoint=http://127.0.0.1:9000&region=eu-west-1", "")
+	require.NoError(err)
+	s3Store := store.(*s3SnapshotStore)
+	require.Equal("bucket", s3Store.cfg.Bucket)
+	require.Equal("ci/", s3Store.cfg.Prefix)
+	require.Equal("eu-west-1", s3Store.cfg.Region)
+	require.Equal("127.0.0.1:9000", s3Store.endpoint.Host)
+	_, err = NewSnapshotStore(logging.NoLog{}, "ftp://host/snapshots", "")
+	require.Error(err)
+}
@@ -0,0 +1,78 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+)
+
+// SnapshotTopology changes the nodes started when loading a snapshot.
+// The zero value starts the nodes recorded in the snapshot, as they were.
+type SnapshotTopology struct {
+	// Recorded nodes that are not loaded
+	DropNodes []string
+	// Recorded nodes that are left paused once loaded, to be resumed with
+	// ResumeNode
+	PausedNodes []string
+	// Fresh nodes added once the recorded ones are loaded, which bootstrap
+	// from them. Names default to the node name pattern, skipping the names
+	// of the dropped nodes.
+	ExtraNodes []node.Config
+}
+
+// Returns [nodeConfigs] without the dropped nodes, or an error if the
+// topology names nodes not in [nodeConfigs].
+func (t SnapshotTopology) filterNodes(nodeConfigs []node.Config) ([]node.Config, error) {
+	recorded := map[string]bool{}
+	for _, nodeConfig := range nodeConfigs {
+		recorded[nodeConfig.Name] = true
+	}
+	dropped := map[string]bool{}
+	for _, nodeName := range t.DropNodes {
+		if !recorded[nodeName] {
+			return nil, fmt.Errorf("node %q to drop not found in snapshot", nodeName)
+		}
+		dropped[nodeName] = true
+	}
+	for _, nodeName := range t.PausedNodes {
+		if !recorded[nodeName] {
+			return nil, fmt.Errorf("node %q to pause not found in snapshot", nodeName)
+		}
+		if dropped[nodeName] {
+			return nil, fmt.Errorf("node %q can't be both dropped and paused", nodeName)
+		}
+	}
+	for _, nodeConfig := range t.ExtraNodes {
+		if nodeConfig.Name != "" && recorded[nodeConfig.Name] {
+			return nil, fmt.Errorf("extra node %q already in snapshot", nodeConfig.Name)
+		}
+	}
+	filtered := []node.Config{}
+	for _, nodeConfig := range nodeConfigs {
+		if !dropped[nodeConfig.Name] {
+			filtered = append(filtered, nodeConfig)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("all the snapshot nodes are dropped")
+	}
+	return filtered, nil
+}
+
+// Returns a node name of the default pattern, not used by the nodes of [ln]
+// nor by the dropped nodes [dropNodes], so that fresh nodes aren't taken for
+// recorded ones.
+// Assumes [ln.lock] is held.
+func (ln *localNetwork) newExtraNodeName(dropNodes []string) string {
+	dropped := map[string]bool{}
+	for _, nodeName := range dropNodes {
+		dropped[nodeName] = true
+	}
+	for {
+		nodeName := fmt.Sprintf("%s%d", defaultNodeNamePrefix, ln.nextNodeSuffix)
+		ln.nextNodeSuffix++
+		if _, ok := ln.nodes[nodeName]; !ok && !dropped[nodeName] {
+			return nodeName
+		}
+	}
+}
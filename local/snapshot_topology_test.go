@@ -0,0 +1,114 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/api"
+	apimocks "github.com/DioneProtocol/odyssey-network-runner/api/mocks"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSnapshotTopology(t *testing.T) {
+	ctx := context.Background()
+	snapshotsDir := t.TempDir()
+	newAPIClient := func(host string, port uint16) api.Client {
+		client := newMockAPISuccessful(host, port).(*apimocks.Client)
+		client.On("OChainAPI").Return(&fakeOChainClient{})
+		return client
+	}
+
+	net, err := newNetwork(logging.NoLog{}, newAPIClient, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, net.loadConfig(ctx, testNetworkConfig(t)))
+	require.NoError(t, awaitNetworkHealthy(net, defaultHealthyTimeout))
+	for _, node := range net.nodes {
+		require.NoError(t, os.MkdirAll(filepath.Join(node.GetDbDir(), constants.NetworkName(net.networkID)), os.ModePerm))
+	}
+	_, err = net.SaveSnapshot(ctx, "snapshot", "", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		topology    SnapshotTopology
+		nodes       []string
+		pausedNodes []string
+		expectedErr string
+	}{
+		{
+			name:        "unchanged",
+			topology:    SnapshotTopology{},
+			nodes:       []string{"node0", "node1", "node2"},
+			pausedNodes: []string{},
+		},
+		{
+			name: "dropped, paused and extra nodes",
+			topology: SnapshotTopology{
+				DropNodes:   []string{"node1"},
+				PausedNodes: []string{"node2"},
+				ExtraNodes: []node.Config{
+					{Name: "extra"},
+					{Flags: map[string]interface{}{"log-level": "debug"}},
+				},
+			},
+			nodes:       []string{"extra", "node0", "node2", "node3"},
+			pausedNodes: []string{"node2"},
+		},
+		{
+			name:        "unknown dropped node",
+			topology:    SnapshotTopology{DropNodes: []string{"node9"}},
+			expectedErr: "not found in snapshot",
+		},
+		{
+			name:        "dropped and paused node",
+			topology:    SnapshotTopology{DropNodes: []string{"node1"}, PausedNodes: []string{"node1"}},
+			expectedErr: "both dropped and paused",
+		},
+		{
+			name:        "extra node in snapshot",
+			topology:    SnapshotTopology{ExtraNodes: []node.Config{{Name: "node0"}}},
+			expectedErr: "already in snapshot",
+		},
+		{
+			name:        "all nodes dropped",
+			topology:    SnapshotTopology{DropNodes: []string{"node0", "node1", "node2"}},
+			expectedErr: "all the snapshot nodes are dropped",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, &localTestSuccessfulNodeProcessCreator{}, t.TempDir(), snapshotsDir, nil, false)
+			require.NoError(err)
+			err = net.loadSnapshot(ctx, "snapshot", "", "", nil, nil, nil, nil, tt.topology, false)
+			if tt.expectedErr != "" {
+				require.ErrorContains(err, tt.expectedErr)
+				require.Empty(net.nodes)
+				return
+			}
+			require.NoError(err)
+			nodeNames, err := net.GetNodeNames()
+			require.NoError(err)
+			require.ElementsMatch(tt.nodes, nodeNames)
+			pausedNodes := []string{}
+			for _, node := range net.nodes {
+				if node.paused {
+					pausedNodes = append(pausedNodes, node.GetName())
+				}
+			}
+			require.Equal(tt.pausedNodes, pausedNodes)
+			require.NoError(awaitNetworkHealthy(net, defaultHealthyTimeout))
+
+			stopCtx, stopCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+			defer stopCtxCancel()
+			require.NoError(net.Stop(stopCtx))
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odysseygo/config"
+	"go.uber.org/zap"
+	"golang.org/x/mod/semver"
+)
+
+// Written in the snapshot dir on save, with the checksums of the files
+// of the snapshot other than the metadata
+const snapshotChecksumsName = "checksums.json"
+
+// Max time for a VM plugin to print its version
+const pluginVersionTimeout = 10 * time.Second
+
+var (
+	ErrSnapshotCorrupted    = errors.New("snapshot files don't match their checksums")
+	ErrIncompatibleSnapshot = errors.New("snapshot incompatible with the node binary or VM plugins")
+)
+
+type snapshotChecksums struct {
+	// Sorted by path
+	Files []SnapshotArchiveFile `json:"files"`
+}
+
+// Writes the checksums of the files of [snapshotDir], which must not
+// change afterwards.
+func writeSnapshotChecksums(snapshotDir string) error {
+	checksums := snapshotChecksums{Files: []SnapshotArchiveFile{}}
+	err := filepath.WalkDir(snapshotDir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		relPath, err := filepath.Rel(snapshotDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath == snapshotMetadataName || relPath == snapshotChecksumsName {
+			return nil
+		}
+		size, checksum, err := hashFile(filePath)
+		if err != nil {
+			return err
+		}
+		checksums.Files = append(checksums.Files, SnapshotArchiveFile{
+			Path:   relPath,
+			Size:   size,
+			SHA256: checksum,
+		})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failure computing snapshot checksums: %w", err)
+	}
+	sort.Slice(checksums.Files, func(i, j int) bool {
+		return checksums.Files[i].Path < checksums.Files[j].Path
+	})
+	checksumsJSON, err := json.MarshalIndent(checksums, "", "    ")
+	if err != nil {
+		return err
+	}
+	return createFileAndWrite(filepath.Join(snapshotDir, snapshotChecksumsName), checksumsJSON)
+}
+
+// Updates the recorded checksum of the file [relPath] of [snapshotDir], after
+// it is rewritten. Snapshots saved without checksums are left as is.
+func updateSnapshotChecksum(snapshotDir string, relPath string) error {
+	checksumsPath := filepath.Join(snapshotDir, snapshotChecksumsName)
+	checksumsJSON, err := os.ReadFile(checksumsPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	checksums := snapshotChecksums{}
+	if err := json.Unmarshal(checksumsJSON, &checksums); err != nil {
+		return fmt.Errorf("%w: invalid checksums file: %s", ErrSnapshotCorrupted, err)
+	}
+	size, checksum, err := hashFile(filepath.Join(snapshotDir, filepath.FromSlash(relPath)))
+	if err != nil {
+		return err
+	}
+	for i := range checksums.Files {
+		if checksums.Files[i].Path == relPath {
+			checksums.Files[i].Size = size
+			checksums.Files[i].SHA256 = checksum
+		}
+	}
+	checksumsJSON, err = json.MarshalIndent(checksums, "", "    ")
+	if err != nil {
+		return err
+	}
+	return replaceFile(checksumsPath, checksumsJSON)
+}
+
+// Returns an error wrapping ErrSnapshotCorrupted if a file of [snapshotDir]
+// is missing or doesn't match its recorded checksum.
+// Snapshots saved without checksums are not verified.
+func (ln *localNetwork) verifySnapshotChecksums(snapshotDir string) error {
+	checksumsJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotChecksumsName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			ln.log.Warn("snapshot has no checksums, not verifying it", zap.String("snapshot-dir", snapshotDir))
+			return nil
+		}
+		return err
+	}
+	checksums := snapshotChecksums{}
+	if err := json.Unmarshal(checksumsJSON, &checksums); err != nil {
+		return fmt.Errorf("%w: invalid checksums file: %s", ErrSnapshotCorrupted, err)
+	}
+	for _, file := range checksums.Files {
+		size, checksum, err := hashFile(filepath.Join(snapshotDir, filepath.FromSlash(file.Path)))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("%w: missing file %q", ErrSnapshotCorrupted, file.Path)
+			}
+			return err
+		}
+		if size != file.Size {
+			return fmt.Errorf("%w: file %q has %d bytes, expected %d", ErrSnapshotCorrupted, file.Path, size, file.Size)
+		}
+		if checksum != file.SHA256 {
+			return fmt.Errorf("%w: file %q has sha256 %s, expected %s", ErrSnapshotCorrupted, file.Path, checksum, file.SHA256)
+		}
+	}
+	return nil
+}
+
+// Returns an error wrapping ErrIncompatibleSnapshot if the DB of the snapshot
+// described by [metadata] can't be used by the binaries or VM plugins of
+// [networkConfig]: a binary has a different DB version, or a binary or
+// plugin is older than the one that saved the snapshot, or a plugin is
+// missing. If [allowIncompatible], the incompatibilities are only logged.
+func (ln *localNetwork) checkSnapshotCompatibility(
+	metadata *SnapshotMetadata,
+	networkConfig network.Config,
+	allowIncompatible bool,
+) error {
+	binaryPaths := map[string]bool{}
+	pluginDirs := map[string]bool{}
+	for _, nodeConfig := range networkConfig.NodeConfigs {
+		binaryPath := nodeConfig.BinaryPath
+		if binaryPath == "" {
+			binaryPath = networkConfig.BinaryPath
+		}
+		// nodes without binary fail to start anyway
+		if binaryPath != "" {
+			binaryPaths[binaryPath] = true
+		}
+		pluginDir, ok := nodeConfig.Flags[config.PluginDirKey].(string)
+		if !ok {
+			pluginDir, _ = networkConfig.Flags[config.PluginDirKey].(string)
+		}
+		if pluginDir != "" {
+			pluginDirs[pluginDir] = true
+		}
+	}
+	errs := []error{}
+	if metadata.OdysseyGoVersion == "" {
+		ln.log.Warn("snapshot has no odysseygo version, not checking binary compatibility", zap.String("snapshot-name", metadata.SnapshotName))
+	} else {
+		for binaryPath := range binaryPaths {
+			errs = append(errs, ln.checkBinaryCompatibility(metadata, binaryPath))
+		}
+	}
+	for pluginDir := range pluginDirs {
+		errs = append(errs, ln.checkPluginsCompatibility(metadata, pluginDir)...)
+	}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if !allowIncompatible {
+			return err
+		}
+		ln.log.Warn("loading snapshot with incompatible binary or plugin", zap.Error(err))
+	}
+	return nil
+}
+
+func (ln *localNetwork) checkBinaryCompatibility(metadata *SnapshotMetadata, binaryPath string) error {
+	nodeVersionOutput, err := ln.nodeProcessCreator.GetNodeVersion(node.Config{BinaryPath: binaryPath})
+	if err != nil {
+		return fmt.Errorf("couldn't get node version with binary %q: %w", binaryPath, err)
+	}
+	nodeSemVer, err := parseNodeSemVer(nodeVersionOutput, binaryPath)
+	if err != nil {
+		return err
+	}
+	dbVersion := parseNodeDBVersion(nodeVersionOutput)
+	if metadata.DatabaseVersion != "" && dbVersion != "" && dbVersion != metadata.DatabaseVersion {
+		return fmt.Errorf(
+			"%w: binary %q %s has database version %s, but the snapshot was saved with database version %s",
+			ErrIncompatibleSnapshot, binaryPath, nodeSemVer, dbVersion, metadata.DatabaseVersion,
+		)
+	}
+	if semver.Compare(nodeSemVer, metadata.OdysseyGoVersion) < 0 {
+		return fmt.Errorf(
+			"%w: binary %q %s is older than the odysseygo %s that saved the snapshot",
+			ErrIncompatibleSnapshot, binaryPath, nodeSemVer, metadata.OdysseyGoVersion,
+		)
+	}
+	return nil
+}
+
+// Returns the errors wrapping ErrIncompatibleSnapshot for the VM plugins of
+// [pluginDir] used by the blockchains of the snapshot described by
+// [metadata]: missing plugins, and plugins older than the ones that saved
+// the snapshot. Plugins without a recorded or known version are not checked.
+func (ln *localNetwork) checkPluginsCompatibility(metadata *SnapshotMetadata, pluginDir string) []error {
+	errs := []error{}
+	checked := map[string]bool{}
+	for _, subnet := range metadata.Subnets {
+		for _, blockchain := range subnet.Blockchains {
+			if checked[blockchain.VMID] {
+				continue
+			}
+			checked[blockchain.VMID] = true
+			// the VMs are recorded by alias, which is the VM ID for plugins
+			// without one
+			recordedVersion, ok := metadata.VMVersions[blockchain.VMID]
+			if !ok && blockchain.VMName != "" {
+				recordedVersion, ok = metadata.VMVersions[blockchain.VMName]
+			}
+			if !ok || !semver.IsValid(recordedVersion) {
+				continue
+			}
+			pluginPath := filepath.Join(pluginDir, blockchain.VMID)
+			if _, err := os.Stat(pluginPath); err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					errs = append(errs, fmt.Errorf(
+						"%w: plugin %q of VM %s %s, used by blockchain %q, is missing",
+						ErrIncompatibleSnapshot, pluginPath, blockchain.VMName, recordedVersion, blockchain.Name,
+					))
+					continue
+				}
+				errs = append(errs, err)
+				continue
+			}
+			pluginVersion, err := getPluginVersion(pluginPath)
+			if err != nil {
+				ln.log.Warn("couldn't get plugin version, not checking its compatibility",
+					zap.String("plugin-path", pluginPath),
+					zap.Error(err),
+				)
+				continue
+			}
+			if semver.Compare(pluginVersion, recordedVersion) < 0 {
+				errs = append(errs, fmt.Errorf(
+					"%w: plugin %q %s is older than the %s that saved the snapshot",
+					ErrIncompatibleSnapshot, pluginPath, pluginVersion, recordedVersion,
+				))
+			}
+		}
+	}
+	return errs
+}
+
+// Returns the first semantic version in the --version output of the VM
+// plugin at [pluginPath].
+func getPluginVersion(pluginPath string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginVersionTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, pluginPath, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("couldn't get version of plugin %q: %w", pluginPath, err)
+	}
+	re := regexp.MustCompile(`v?(\d+\.\d+\.\d+)`)
+	matches := re.FindStringSubmatch(string(output))
+	if len(matches) != 2 {
+		return "", fmt.Errorf("invalid version output %q for plugin %q: version pattern not found", output, pluginPath)
+	}
+	return "v" + matches[1], nil
+}
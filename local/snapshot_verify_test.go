@@ -0,0 +1,253 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/api"
+	apimocks "github.com/DioneProtocol/odyssey-network-runner/api/mocks"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odysseygo/config"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// Creates successful node processes of binaries with the given version output
+type localTestVersionProcessCreator struct {
+	localTestSuccessfulNodeProcessCreator
+	versionOutput string
+}
+
+func (c *localTestVersionProcessCreator) GetNodeVersion(node.Config) (string, error) {
+	return c.versionOutput, nil
+}
+
+func TestParseNodeDBVersion(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("v1.4.5", parseNodeDBVersion("odysseygo/1.10.10 [database=v1.4.5, rpcchainvm=26, go=1.20.10]\n"))
+	require.Equal("v1.4.5", parseNodeDBVersion("odysseygo/1.10.10 [database=v1.4.5]"))
+	require.Empty(parseNodeDBVersion(nodeVersion))
+}
+
+func TestLoadSnapshotVerification(t *testing.T) {
+	ctx := context.Background()
+	snapshotsDir := t.TempDir()
+	creator := &localTestVersionProcessCreator{
+		versionOutput: "odysseygo/1.10.10 [database=v1.4.5, rpcchainvm=26, go=1.20.10]",
+	}
+
+	newAPIClient := func(host string, port uint16) api.Client {
+		client := newMockAPISuccessful(host, port).(*apimocks.Client)
+		client.On("OChainAPI").Return(&fakeOChainClient{})
+		return client
+	}
+
+	net, err := newNetwork(logging.NoLog{}, newAPIClient, creator, t.TempDir(), snapshotsDir, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, net.loadConfig(ctx, testNetworkConfig(t)))
+	require.NoError(t, awaitNetworkHealthy(net, defaultHealthyTimeout))
+	for _, node := range net.nodes {
+		dbDir := filepath.Join(node.GetDbDir(), constants.NetworkName(net.networkID))
+		require.NoError(t, os.MkdirAll(dbDir, os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(dbDir, "000001.ldb"), []byte(node.GetName()), 0o600))
+	}
+	snapshotDir, err := net.SaveSnapshot(ctx, "snapshot", "", nil)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(snapshotDir, snapshotChecksumsName))
+	metadata, err := GetSnapshotMetadata(snapshotsDir, "snapshot")
+	require.NoError(t, err)
+	require.Equal(t, "v1.10.10", metadata.OdysseyGoVersion)
+	require.Equal(t, "v1.4.5", metadata.DatabaseVersion)
+	require.Equal(t, map[string]string{"omegavm": "v1.9.5"}, metadata.VMVersions)
+	dbFile := filepath.Join(snapshotDir, defaultDBSubdir, "node0", constants.NetworkName(net.networkID), "000001.ldb")
+
+	tests := []struct {
+		name              string
+		corrupt           func(t *testing.T)
+		versionOutput     string
+		allowIncompatible bool
+		expectedErr       error
+	}{
+		{
+			name:          "same version",
+			versionOutput: creator.versionOutput,
+		},
+		{
+			name:          "newer version",
+			versionOutput: "odysseygo/1.11.0 [database=v1.4.5, rpcchainvm=27, go=1.20.10]",
+		},
+		{
+			name:          "older version",
+			versionOutput: "odysseygo/1.10.9 [database=v1.4.5, rpcchainvm=26, go=1.20.10]",
+			expectedErr:   ErrIncompatibleSnapshot,
+		},
+		{
+			name:          "other database version",
+			versionOutput: "odysseygo/1.11.0 [database=v1.5.0, rpcchainvm=27, go=1.20.10]",
+			expectedErr:   ErrIncompatibleSnapshot,
+		},
+		{
+			name:              "allowed incompatible version",
+			versionOutput:     "odysseygo/1.10.9 [database=v1.4.5, rpcchainvm=26, go=1.20.10]",
+			allowIncompatible: true,
+		},
+		{
+			name: "truncated file",
+			corrupt: func(t *testing.T) {
+				require.NoError(t, os.Truncate(dbFile, 1))
+			},
+			versionOutput: creator.versionOutput,
+			expectedErr:   ErrSnapshotCorrupted,
+		},
+		{
+			name: "modified file",
+			corrupt: func(t *testing.T) {
+				require.NoError(t, os.WriteFile(dbFile, []byte("nodeX"), 0o600))
+			},
+			versionOutput: creator.versionOutput,
+			expectedErr:   ErrSnapshotCorrupted,
+		},
+		{
+			name: "missing file",
+			corrupt: func(t *testing.T) {
+				require.NoError(t, os.Remove(dbFile))
+			},
+			versionOutput: creator.versionOutput,
+			expectedErr:   ErrSnapshotCorrupted,
+		},
+		{
+			name: "without checksums",
+			corrupt: func(t *testing.T) {
+				require.NoError(t, os.Remove(filepath.Join(snapshotDir, snapshotChecksumsName)))
+			},
+			versionOutput: creator.versionOutput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			checksumsJSON, err := os.ReadFile(filepath.Join(snapshotDir, snapshotChecksumsName))
+			require.NoError(err)
+			dbFileData, err := os.ReadFile(dbFile)
+			require.NoError(err)
+			if tt.corrupt != nil {
+				// the snapshot file may be hardlinked to the objects
+				require.NoError(os.Remove(dbFile))
+				require.NoError(os.WriteFile(dbFile, dbFileData, 0o600))
+				tt.corrupt(t)
+				defer func() {
+					require.NoError(os.WriteFile(dbFile, dbFileData, 0o600))
+					require.NoError(os.WriteFile(filepath.Join(snapshotDir, snapshotChecksumsName), checksumsJSON, 0o600))
+				}()
+			}
+
+			creator := &localTestVersionProcessCreator{versionOutput: tt.versionOutput}
+			net, err := newNetwork(logging.NoLog{}, newMockAPISuccessful, creator, t.TempDir(), snapshotsDir, nil, false)
+			require.NoError(err)
+			err = net.loadSnapshot(ctx, "snapshot", "", "", nil, nil, nil, nil, SnapshotTopology{}, tt.allowIncompatible)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				require.Empty(net.nodes)
+				return
+			}
+			require.Len(net.nodes, 3)
+			stopCtx, stopCtxCancel := context.WithTimeout(ctx, 10*time.Second)
+			defer stopCtxCancel()
+			require.NoError(net.Stop(stopCtx))
+		})
+	}
+
+	// checksums are not affected by the metadata
+	metadataPath := filepath.Join(snapshotDir, snapshotMetadataName)
+	metadata.Labels = map[string]string{"edited": "true"}
+	metadataJSON, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(metadataPath, metadataJSON, 0o600))
+	net, err = newNetwork(logging.NoLog{}, newMockAPISuccessful, creator, t.TempDir(), snapshotsDir, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, net.verifySnapshotChecksums(snapshotDir))
+}
+
+// Writes a VM plugin [vmID] in [pluginDir] printing [versionOutput]
+func writeTestPlugin(t *testing.T, pluginDir string, vmID string, versionOutput string) {
+	script := fmt.Sprintf("#!/bin/sh\necho '%s'\n", versionOutput)
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, vmID), []byte(script), 0o700)) //nolint:gosec
+}
+
+func TestCheckPluginsCompatibility(t *testing.T) {
+	vmID := "srEXiWaHuhNyGwPUi444Tu47ZEDwxTWrbQiuD7FmgSAQ6X7Dy"
+	metadata := &SnapshotMetadata{
+		SnapshotName: "snapshot",
+		VMVersions:   map[string]string{"omegavm": "v1.9.5", "subnetevm": "v0.5.2"},
+		Subnets: []SnapshotSubnet{
+			{
+				ID: "subnet",
+				Blockchains: []SnapshotBlockchain{
+					{ID: "blockchain1", Name: "chain1", VMID: vmID, VMName: "subnetevm"},
+					{ID: "blockchain2", Name: "chain2", VMID: vmID, VMName: "subnetevm"},
+					// no recorded version
+					{ID: "blockchain3", Name: "chain3", VMID: "otherVMID"},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name              string
+		versionOutput     string
+		missing           bool
+		allowIncompatible bool
+		expectedErr       error
+	}{
+		{
+			name:          "same version",
+			versionOutput: "Subnet-EVM/v0.5.2 [OdysseyGo=v1.10.10, rpcchainvm=26]",
+		},
+		{
+			name:          "newer version",
+			versionOutput: "Subnet-EVM/v0.5.3 [OdysseyGo=v1.10.10, rpcchainvm=26]",
+		},
+		{
+			name:          "older version",
+			versionOutput: "Subnet-EVM/v0.5.1 [OdysseyGo=v1.10.10, rpcchainvm=26]",
+			expectedErr:   ErrIncompatibleSnapshot,
+		},
+		{
+			name:              "allowed older version",
+			versionOutput:     "Subnet-EVM/v0.5.1 [OdysseyGo=v1.10.10, rpcchainvm=26]",
+			allowIncompatible: true,
+		},
+		{
+			name:        "missing plugin",
+			missing:     true,
+			expectedErr: ErrIncompatibleSnapshot,
+		},
+		{
+			name:          "unknown version",
+			versionOutput: "Subnet-EVM",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginDir := t.TempDir()
+			if !tt.missing {
+				writeTestPlugin(t, pluginDir, vmID, tt.versionOutput)
+			}
+			ln := &localNetwork{log: logging.NoLog{}}
+			networkConfig := network.Config{
+				Flags:       map[string]interface{}{config.PluginDirKey: pluginDir},
+				NodeConfigs: []node.Config{{Name: "node1", Flags: map[string]interface{}{}}},
+			}
+			err := ln.checkSnapshotCompatibility(metadata, networkConfig, tt.allowIncompatible)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package local
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node/status"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"go.uber.org/zap"
+)
+
+var _ NodeProcess = (*supervisedProcess)(nil)
+
+const (
+	defaultRestartBackoff    = time.Second
+	defaultRestartMaxBackoff = time.Minute
+)
+
+// supervisedProcess is a node process that is relaunched with the same
+// config and args when it exits without being stopped, as allowed by the
+// node restart policy.
+type supervisedProcess struct {
+	log     logging.Logger
+	creator NodeProcessCreator
+	// Called on unexpected exits and relaunches. May be nil.
+	onEvent func(network.NodeEvent)
+	config  node.Config
+	args    []string
+
+	lock    sync.RWMutex
+	process NodeProcess
+	// True if the process exited and is waiting to be relaunched
+	restarting   bool
+	restartCount uint32
+	lastExitCode int
+	stopCalled   bool
+	// Closed when [Stop] is called
+	stopCh chan struct{}
+	// Closed when the process exits and is not relaunched
+	doneCh chan struct{}
+
+	resources resourceMonitor
+}
+
+// Starts a node process using [creator], and supervises it.
+func newSupervisedProcess(
+	log logging.Logger,
+	creator NodeProcessCreator,
+	onEvent func(network.NodeEvent),
+	config node.Config,
+	args ...string,
+) (*supervisedProcess, error) {
+	process, err := creator.NewNodeProcess(config, args...)
+	if err != nil {
+		return nil, err
+	}
+	p := &supervisedProcess{
+		log:     log,
+		creator: creator,
+		onEvent: onEvent,
+		config:  config,
+		args:    args,
+		process: process,
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	go p.supervise()
+	go p.sampleResources()
+	return p, nil
+}
+
+func (p *supervisedProcess) supervise() {
+	defer close(p.doneCh)
+
+	for {
+		p.lock.RLock()
+		process := p.process
+		p.lock.RUnlock()
+
+		<-process.Done()
+
+		backoff, ok := p.onExit(process)
+		if !ok {
+			return
+		}
+		select {
+		case <-p.stopCh:
+			return
+		case <-time.After(backoff):
+		}
+		if !p.relaunch() {
+			return
+		}
+	}
+}
+
+// Samples the resource usage of the process until it exits and is not
+// relaunched.
+func (p *supervisedProcess) sampleResources() {
+	ticker := time.NewTicker(resourceSampleFreq)
+	defer ticker.Stop()
+
+	for {
+		p.lock.RLock()
+		pid := p.process.Pid()
+		p.lock.RUnlock()
+		if pid != 0 {
+			if err := p.resources.sample(int32(pid)); err != nil {
+				p.log.Debug("couldn't sample node process resources", zap.String("node", p.config.Name), zap.Error(err))
+			}
+		}
+		select {
+		case <-p.doneCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Records the exit of [process], and returns the time to wait before
+// relaunching it, or false if it must not be relaunched.
+func (p *supervisedProcess) onExit(process NodeProcess) (time.Duration, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopCalled {
+		return 0, false
+	}
+	exitCode := process.ExitCode()
+	p.lastExitCode = exitCode
+	p.log.Warn("node process exited unexpectedly",
+		zap.String("node", p.config.Name),
+		zap.Int("exit-code", exitCode),
+		zap.Uint32("restart-count", p.restartCount),
+	)
+	p.publish(network.NodeEvent{Type: network.NodeCrashed, NodeName: p.config.Name, ExitCode: exitCode})
+	if !p.shouldRestart(exitCode) {
+		return 0, false
+	}
+	p.restarting = true
+	return p.backoff(), true
+}
+
+// Relaunches the process, unless [Stop] was called in the meantime.
+// Returns false if the process was not relaunched.
+func (p *supervisedProcess) relaunch() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.restarting = false
+	if p.stopCalled {
+		return false
+	}
+	process, err := p.creator.NewNodeProcess(p.config, p.args...)
+	if err != nil {
+		p.log.Error("couldn't relaunch node process", zap.String("node", p.config.Name), zap.Error(err))
+		return false
+	}
+	p.process = process
+	p.restartCount++
+	p.log.Info("relaunched node process",
+		zap.String("node", p.config.Name),
+		zap.Uint32("restart-count", p.restartCount),
+	)
+	p.publish(network.NodeEvent{Type: network.NodeRelaunched, NodeName: p.config.Name})
+	return true
+}
+
+func (p *supervisedProcess) publish(event network.NodeEvent) {
+	if p.onEvent != nil {
+		p.onEvent(event)
+	}
+}
+
+// Returns true if the restart policy allows relaunching a process
+// that exited with [exitCode].
+// Assumes [p.lock] is held.
+func (p *supervisedProcess) shouldRestart(exitCode int) bool {
+	policy := p.config.RestartPolicy
+	switch policy.Type {
+	case node.RestartAlways:
+	case node.RestartOnFailure:
+		if exitCode == 0 {
+			return false
+		}
+	default:
+		return false
+	}
+	return policy.MaxRetries == 0 || p.restartCount < policy.MaxRetries
+}
+
+// Returns the wait before the next relaunch, doubled after each one.
+// Assumes [p.lock] is held.
+func (p *supervisedProcess) backoff() time.Duration {
+	backoff := p.config.RestartPolicy.Backoff
+	if backoff == 0 {
+		backoff = defaultRestartBackoff
+	}
+	maxBackoff := p.config.RestartPolicy.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = defaultRestartMaxBackoff
+	}
+	for i := uint32(0); i < p.restartCount && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// Stops the process, and prevents it from being relaunched.
+func (p *supervisedProcess) Stop(ctx context.Context) int {
+	p.lock.Lock()
+	if !p.stopCalled {
+		p.stopCalled = true
+		close(p.stopCh)
+	}
+	process := p.process
+	p.lock.Unlock()
+
+	exitCode := process.Stop(ctx)
+	<-p.doneCh
+	return exitCode
+}
+
+// Returns [status.Running] while an exited process is going to be relaunched.
+func (p *supervisedProcess) Status() status.Status {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.restarting {
+		return status.Running
+	}
+	processStatus := p.process.Status()
+	if processStatus == status.Stopped && !p.stopCalled && p.shouldRestart(p.process.ExitCode()) {
+		// exited, but the supervisor has not noticed yet
+		return status.Running
+	}
+	return processStatus
+}
+
+// Returns a channel that is closed when the process exits and is
+// not going to be relaunched.
+func (p *supervisedProcess) Done() <-chan struct{} {
+	return p.doneCh
+}
+
+func (p *supervisedProcess) ExitCode() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.restarting {
+		return -1
+	}
+	return p.process.ExitCode()
+}
+
+// Returns the number of times the process was relaunched.
+func (p *supervisedProcess) RestartCount() uint32 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.restartCount
+}
+
+func (p *supervisedProcess) Pid() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.process.Pid()
+}
+
+// Returns the last resource usage samples of the process, oldest first.
+func (p *supervisedProcess) ResourceUsage() []node.ResourceUsage {
+	return p.resources.History()
+}
+
+// Returns the exit code of the last unexpected exit of the process.
+func (p *supervisedProcess) LastExitCode() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.lastExitCode
+}
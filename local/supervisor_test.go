@@ -0,0 +1,217 @@
+package local
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node/status"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProcess is a node process that exits when stopped or crashed
+type fakeProcess struct {
+	lock     sync.Mutex
+	exitCode int
+	exited   bool
+	doneCh   chan struct{}
+}
+
+func (p *fakeProcess) exit(exitCode int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.exited {
+		return
+	}
+	p.exited = true
+	p.exitCode = exitCode
+	close(p.doneCh)
+}
+
+func (p *fakeProcess) Stop(context.Context) int {
+	p.exit(0)
+	return p.ExitCode()
+}
+
+func (p *fakeProcess) Status() status.Status {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.exited {
+		return status.Stopped
+	}
+	return status.Running
+}
+
+func (p *fakeProcess) Done() <-chan struct{} {
+	return p.doneCh
+}
+
+func (*fakeProcess) Pid() int {
+	return 0
+}
+
+func (p *fakeProcess) ExitCode() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !p.exited {
+		return -1
+	}
+	return p.exitCode
+}
+
+type fakeProcessCreator struct {
+	lock      sync.Mutex
+	processes []*fakeProcess
+}
+
+func (c *fakeProcessCreator) NewNodeProcess(node.Config, ...string) (NodeProcess, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	process := &fakeProcess{doneCh: make(chan struct{})}
+	c.processes = append(c.processes, process)
+	return process, nil
+}
+
+func (*fakeProcessCreator) GetNodeVersion(node.Config) (string, error) {
+	return nodeVersion, nil
+}
+
+// Returns the last launched process
+func (c *fakeProcessCreator) last() *fakeProcess {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.processes[len(c.processes)-1]
+}
+
+func (c *fakeProcessCreator) numLaunches() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return len(c.processes)
+}
+
+func newTestSupervisedProcess(t *testing.T, policy node.RestartPolicy) (*supervisedProcess, *fakeProcessCreator) {
+	creator := &fakeProcessCreator{}
+	p, err := newSupervisedProcess(logging.NoLog{}, creator, nil, node.Config{RestartPolicy: policy})
+	require.NoError(t, err)
+	return p, creator
+}
+
+func TestSupervisorNever(t *testing.T) {
+	require := require.New(t)
+
+	p, creator := newTestSupervisedProcess(t, node.RestartPolicy{})
+	creator.last().exit(2)
+	<-p.Done()
+	require.Equal(status.Stopped, p.Status())
+	require.Equal(2, p.LastExitCode())
+	require.Zero(p.RestartCount())
+	require.Equal(1, creator.numLaunches())
+	require.Equal(2, p.Stop(context.Background()))
+}
+
+func TestSupervisorOnFailure(t *testing.T) {
+	require := require.New(t)
+
+	p, creator := newTestSupervisedProcess(t, node.RestartPolicy{
+		Type:       node.RestartOnFailure,
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+	})
+	creator.last().exit(1)
+	require.Eventually(func() bool {
+		return creator.numLaunches() == 2
+	}, 5*time.Second, time.Millisecond)
+	require.Equal(status.Running, p.Status())
+	require.Equal(uint32(1), p.RestartCount())
+	require.Equal(1, p.LastExitCode())
+
+	// clean exits are not relaunched
+	creator.last().exit(0)
+	<-p.Done()
+	require.Equal(2, creator.numLaunches())
+	require.Equal(0, p.LastExitCode())
+
+	// retries are bounded
+	p, creator = newTestSupervisedProcess(t, node.RestartPolicy{
+		Type:       node.RestartOnFailure,
+		MaxRetries: 2,
+		Backoff:    time.Millisecond,
+	})
+	for i := 0; i < 3; i++ {
+		require.Eventually(func() bool {
+			return creator.numLaunches() == i+1
+		}, 5*time.Second, time.Millisecond)
+		creator.last().exit(1)
+	}
+	<-p.Done()
+	require.Equal(3, creator.numLaunches())
+	require.Equal(uint32(2), p.RestartCount())
+}
+
+func TestSupervisorStop(t *testing.T) {
+	require := require.New(t)
+
+	p, creator := newTestSupervisedProcess(t, node.RestartPolicy{Type: node.RestartAlways})
+	require.Zero(p.Stop(context.Background()))
+	<-p.Done()
+	require.Equal(1, creator.numLaunches())
+	require.Zero(p.RestartCount())
+
+	// stop while waiting to relaunch
+	p, creator = newTestSupervisedProcess(t, node.RestartPolicy{Type: node.RestartAlways, Backoff: time.Hour})
+	creator.last().exit(0)
+	require.Eventually(func() bool {
+		return p.ExitCode() == -1
+	}, 5*time.Second, time.Millisecond)
+	require.Equal(status.Running, p.Status())
+	p.Stop(context.Background())
+	<-p.Done()
+	require.Equal(1, creator.numLaunches())
+}
+
+func TestRestartPolicyBackoff(t *testing.T) {
+	require := require.New(t)
+
+	p := &supervisedProcess{config: node.Config{RestartPolicy: node.RestartPolicy{
+		Backoff:    time.Second,
+		MaxBackoff: 5 * time.Second,
+	}}}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for _, backoff := range expected {
+		require.Equal(backoff, p.backoff())
+		p.restartCount++
+	}
+}
+
+func TestSupervisorEvents(t *testing.T) {
+	require := require.New(t)
+
+	events := make(chan network.NodeEvent, 10)
+	creator := &fakeProcessCreator{}
+	config := node.Config{
+		Name:          "node1",
+		RestartPolicy: node.RestartPolicy{Type: node.RestartOnFailure, Backoff: time.Millisecond},
+	}
+	p, err := newSupervisedProcess(logging.NoLog{}, creator, func(event network.NodeEvent) {
+		events <- event
+	}, config)
+	require.NoError(err)
+
+	creator.last().exit(3)
+	require.Equal(network.NodeEvent{Type: network.NodeCrashed, NodeName: "node1", ExitCode: 3}, <-events)
+	require.Equal(network.NodeEvent{Type: network.NodeRelaunched, NodeName: "node1"}, <-events)
+
+	// intentional stops are not reported
+	require.Zero(p.Stop(context.Background()))
+	require.Empty(events)
+}
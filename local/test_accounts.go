@@ -0,0 +1,190 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/DioneProtocol/coreth/core/types"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+const (
+	// gas of a plain value transfer on the D-chain
+	dChainTransferGas = 21_000
+	// check period while waiting for the D-chain transfers to be accepted
+	dChainTransferPollFrequency = 100 * time.Millisecond
+)
+
+// Number of wei in a nDIONE, as the D-chain has 18 decimals instead of 9
+var dChainWeiPerNDione = big.NewInt(1_000_000_000)
+
+// NewTestAccountKeysFromSeed returns [numAccounts] secp256k1 keys, to be used
+// as test accounts. The same seed always gives the same keys.
+func NewTestAccountKeysFromSeed(seed int64, numAccounts uint32) ([]*secp256k1.PrivateKey, error) {
+	factory := secp256k1.Factory{}
+	keys := make([]*secp256k1.PrivateKey, numAccounts)
+	for i := range keys {
+		key, err := factory.ToPrivateKey(seededKeyMaterial("account", seed, uint32(i), 0))
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// DChainBalance returns the wei amount of [nDioneAmount] nDIONE.
+func DChainBalance(nDioneAmount uint64) *big.Int {
+	balance := new(big.Int).SetUint64(nDioneAmount)
+	return balance.Mul(balance, dChainWeiPerNDione)
+}
+
+// NewAccount returns the addresses of [key].
+func NewAccount(key *secp256k1.PrivateKey) network.Account {
+	return network.Account{
+		Addr:    key.Address(),
+		EthAddr: ids.ShortID(crypto.PubkeyToAddress(key.ToECDSA().PublicKey)),
+	}
+}
+
+func (ln *localNetwork) FundAccounts(
+	ctx context.Context,
+	accounts []network.Account,
+	amount uint64,
+) error {
+	ln.lock.Lock()
+	defer ln.lock.Unlock()
+
+	return ln.fundAccounts(ctx, accounts, amount)
+}
+
+// Sends [amount] nDIONE on the A-, O- and D-chains to each of [accounts],
+// from the EWOQ key. Returns once all the txs are accepted.
+func (ln *localNetwork) fundAccounts(
+	ctx context.Context,
+	accounts []network.Account,
+	amount uint64,
+) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	ln.log.Info("funding accounts", zap.Int("num-accounts", len(accounts)), zap.Uint64("amount", amount))
+	clientURI, err := ln.getClientURI()
+	if err != nil {
+		return err
+	}
+	w, err := newWallet(ctx, clientURI, []ids.ID{})
+	if err != nil {
+		return err
+	}
+	outputs := make([]*dione.TransferableOutput, len(accounts))
+	ethAddrs := make([]ids.ShortID, len(accounts))
+	for i, account := range accounts {
+		outputs[i] = &dione.TransferableOutput{
+			Asset: dione.Asset{ID: w.aWallet.DIONEAssetID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{account.Addr},
+				},
+			},
+		}
+		ethAddrs[i] = account.EthAddr
+	}
+
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	tx, err := w.aWallet.IssueBaseTx(outputs, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return fmt.Errorf("couldn't fund accounts on the A-chain: %w", err)
+	}
+	ln.log.Info("funded accounts on the A-chain", zap.String("tx-id", tx.ID().String()))
+
+	// the O-chain wallet doesn't share the outputs with the A-chain one
+	cctx, cancel = createDefaultCtx(ctx)
+	defer cancel()
+	oTx, err := w.oWallet.IssueBaseTx(outputs, common.WithContext(cctx), defaultPoll)
+	if err != nil {
+		return fmt.Errorf("couldn't fund accounts on the O-chain: %w", err)
+	}
+	ln.log.Info("funded accounts on the O-chain", zap.String("tx-id", oTx.ID().String()))
+
+	if _, err := ln.transferDChain(ctx, ethAddrs, DChainBalance(amount)); err != nil {
+		return fmt.Errorf("couldn't fund accounts on the D-chain: %w", err)
+	}
+	ln.log.Info("funded accounts on the D-chain")
+	return nil
+}
+
+// Transfers [balance] wei to each of [addrs] on the D-chain, from the EWOQ
+// key, and waits for the transfers to be accepted. Returns the tx hashes.
+func (ln *localNetwork) transferDChain(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	balance *big.Int,
+) ([]ethcommon.Hash, error) {
+	cctx, cancel := createDefaultCtx(ctx)
+	defer cancel()
+	ethClient := ln.getNode().GetAPIClient().DChainEthAPI()
+	ewoqKey := genesis.EWOQKey.ToECDSA()
+	ewoqAddr := crypto.PubkeyToAddress(ewoqKey.PublicKey)
+	chainID, err := ethClient.ChainID(cctx)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := ethClient.SuggestGasPrice(cctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := ethClient.NonceAt(cctx, ewoqAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	txHashes := make([]ethcommon.Hash, len(addrs))
+	for i, addr := range addrs {
+		to := ethcommon.Address(addr)
+		tx, err := types.SignTx(types.NewTx(&types.LegacyTx{
+			Nonce:    nonce + uint64(i),
+			GasPrice: gasPrice,
+			Gas:      dChainTransferGas,
+			To:       &to,
+			Value:    balance,
+		}), signer, ewoqKey)
+		if err != nil {
+			return nil, err
+		}
+		if err := ethClient.SendTransaction(cctx, tx); err != nil {
+			return nil, err
+		}
+		txHashes[i] = tx.Hash()
+	}
+	// the nonce only includes accepted txs
+	finalNonce := nonce + uint64(len(addrs))
+	for {
+		acceptedNonce, err := ethClient.AcceptedNonceAt(cctx, ewoqAddr)
+		if err != nil {
+			return nil, err
+		}
+		if acceptedNonce >= finalNonce {
+			return txHashes, nil
+		}
+		select {
+		case <-cctx.Done():
+			return nil, cctx.Err()
+		case <-time.After(dChainTransferPollFrequency):
+		}
+	}
+}
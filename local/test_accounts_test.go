@@ -0,0 +1,45 @@
+package local
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTestAccountKeysFromSeed(t *testing.T) {
+	require := require.New(t)
+
+	keys, err := NewTestAccountKeysFromSeed(1, 3)
+	require.NoError(err)
+	require.Len(keys, 3)
+	sameKeys, err := NewTestAccountKeysFromSeed(1, 2)
+	require.NoError(err)
+	require.Equal(keys[:2], sameKeys)
+	require.NotEqual(keys[0].Address(), keys[1].Address())
+	otherSeedKeys, err := NewTestAccountKeysFromSeed(2, 1)
+	require.NoError(err)
+	require.NotEqual(keys[0].Address(), otherSeedKeys[0].Address())
+
+	noKeys, err := NewTestAccountKeysFromSeed(1, 0)
+	require.NoError(err)
+	require.Empty(noKeys)
+}
+
+func TestNewAccount(t *testing.T) {
+	require := require.New(t)
+
+	account := NewAccount(genesis.EWOQKey)
+	require.Equal(genesis.EWOQKey.Address(), account.Addr)
+	// EWOQ address on the D-chain allocations of the local genesis
+	require.Equal(common.HexToAddress("0x8db97C7cEcE249c2b98bDC0226Cc4C2A57BF52FC"), common.Address(account.EthAddr))
+}
+
+func TestDChainBalance(t *testing.T) {
+	expected, ok := new(big.Int).SetString("1000000000000000000", 10)
+	require.True(t, ok)
+	require.Equal(t, expected, DChainBalance(units.Dione))
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// How often a followed log file is checked for new records
+const followPollFreq = 250 * time.Millisecond
+
+// Follow sends to [entryCh] the last [lines] entries of the log [logName] in
+// [logsDir] selected by [filter], then the new ones as they are written, until
+// [ctx] is done. When the log is rotated, the rest of the old file is read
+// before switching to the new one.
+func Follow(
+	ctx context.Context,
+	logsDir string,
+	logName string,
+	lines int,
+	filter Filter,
+	entryCh chan<- Entry,
+) error {
+	path := logPath(logsDir, logName)
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrLogNotFound, path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	send := func(entry *Entry) error {
+		if entry == nil || !filter.Match(*entry) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case entryCh <- *entry:
+			return nil
+		}
+	}
+
+	// skip the existing records, except for the last [lines] ones
+	p := newParser(logName)
+	r := bufio.NewReaderSize(f, 64*1024)
+	last := []Entry{}
+	keep := func(entry *Entry) {
+		if entry == nil || lines <= 0 || !filter.Match(*entry) {
+			return
+		}
+		last = append(last, *entry)
+		if len(last) > lines {
+			last = last[1:]
+		}
+	}
+	partial := ""
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			partial = line
+			break
+		}
+		keep(p.parseLine(strings.TrimSuffix(line, "\n")))
+	}
+	keep(p.flush())
+	for i := range last {
+		if err := send(&last[i]); err != nil {
+			return err
+		}
+	}
+
+	offset, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	offset -= int64(r.Buffered())
+	for {
+		line, err := r.ReadString('\n')
+		if err == nil {
+			offset += int64(len(line))
+			if err := send(p.parseLine(strings.TrimSuffix(partial+line, "\n"))); err != nil {
+				return err
+			}
+			partial = ""
+			continue
+		}
+		if !errors.Is(err, io.EOF) {
+			return err
+		}
+		offset += int64(len(line))
+		partial += line
+
+		// the last record is complete once the file stops growing
+		if err := send(p.flush()); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(followPollFreq):
+		}
+
+		reopen, truncated, err := checkRotation(f, path, offset)
+		if err != nil {
+			return err
+		}
+		switch {
+		case truncated:
+			if _, err := f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		case reopen:
+			// drain the records written to the old file before the rotation
+			if rest, err := io.ReadAll(r); err == nil && len(rest) > 0 {
+				for _, line := range strings.Split(strings.TrimSuffix(partial+string(rest), "\n"), "\n") {
+					if err := send(p.parseLine(line)); err != nil {
+						return err
+					}
+				}
+				if err := send(p.flush()); err != nil {
+					return err
+				}
+			}
+			partial = ""
+			newF, err := os.Open(path)
+			if err != nil {
+				// not recreated yet
+				continue
+			}
+			_ = f.Close()
+			f = newF
+		default:
+			continue
+		}
+		r.Reset(f)
+		offset = 0
+		partial = ""
+	}
+}
+
+// Returns whether the file at [path] is no longer [f], meaning the log was
+// rotated, or whether [f] was truncated below [offset].
+func checkRotation(f *os.File, path string, offset int64) (bool, bool, error) {
+	openInfo, err := f.Stat()
+	if err != nil {
+		return false, false, err
+	}
+	if openInfo.Size() < offset {
+		return false, true, nil
+	}
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		// the log was moved and not recreated yet
+		return errors.Is(err, os.ErrNotExist), false, nil
+	}
+	return !os.SameFile(openInfo, pathInfo), false, nil
+}
@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package logs reads the log files written by odysseygo nodes, including
+// the backups left by their rotation, and follows them as they are written.
+package logs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+const (
+	// Name of the log of the node, as opposed to the chain logs
+	MainLogName = "main"
+
+	logFileExt = ".log"
+	// Time layout of the plain log format, which has no year
+	plainTimeLayout = "[01-02|15:04:05.000]"
+	// Time layout of the json log format
+	jsonTimeLayout = "2006-01-02T15:04:05.000Z0700"
+	// Max length of a log line
+	maxLineSize = 1024 * 1024
+)
+
+var (
+	ErrLogNotFound = errors.New("log file not found")
+
+	// Start of a record in the plain format: time and level
+	plainRecordRegexp = regexp.MustCompile(`^(\[\d{2}-\d{2}\|\d{2}:\d{2}:\d{2}\.\d{3}\]) ([A-Z]+) `)
+)
+
+// Entry is a log record. Lines that don't start a record, such as the
+// ones of a stack trace, are appended to the previous record.
+type Entry struct {
+	// Name of the log file, without extension
+	LogName string
+	// Zero if the record time is unknown
+	Time time.Time
+	// Empty if the record level is unknown
+	Level string
+	// Record text, as written
+	Text string
+}
+
+// Filter selects log entries.
+type Filter struct {
+	// Entries of a lower level are excluded. With [logging.Verbo],
+	// entries of unknown level are also included.
+	MinLevel logging.Level
+	// Time range of the entries. Zero values mean no bound.
+	Since time.Time
+	Until time.Time
+	// If not empty, entries must contain it
+	Contains string
+}
+
+// Returns true if [entry] is selected by [f].
+func (f Filter) Match(entry Entry) bool {
+	if f.MinLevel > logging.Verbo {
+		level, err := logging.ToLevel(entry.Level)
+		if err != nil || level < f.MinLevel {
+			return false
+		}
+	}
+	if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.Time.After(f.Until) {
+		return false
+	}
+	return f.Contains == "" || strings.Contains(entry.Text, f.Contains)
+}
+
+// Returns the first of [logNames] that has a log file in [logsDir].
+func FindLogName(logsDir string, logNames ...string) (string, error) {
+	for _, logName := range logNames {
+		if logName == "" || strings.ContainsAny(logName, `/\`) {
+			continue
+		}
+		if _, err := os.Stat(logPath(logsDir, logName)); err == nil {
+			return logName, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q in %s", ErrLogNotFound, logNames, logsDir)
+}
+
+// Read returns the entries of the log [logName] in [logsDir] selected by
+// [filter], oldest first, including the ones in rotated backups.
+func Read(logsDir string, logName string, filter Filter) ([]Entry, error) {
+	path := logPath(logsDir, logName)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLogNotFound, path)
+	}
+	backups, err := filepath.Glob(filepath.Join(logsDir, logName+"-*"+logFileExt+"*"))
+	if err != nil {
+		return nil, err
+	}
+	// backup names end with their rotation time, so they sort by age
+	sort.Strings(backups)
+
+	entries := []Entry{}
+	for _, path := range append(backups, path) {
+		fileEntries, err := readFile(path, logName, filter)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	return entries, nil
+}
+
+func readFile(path string, logName string, filter Filter) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read %s: %w", path, err)
+		}
+		defer gr.Close()
+		r = gr
+	}
+
+	entries := []Entry{}
+	p := newParser(logName)
+	add := func(entry *Entry) {
+		if entry != nil && filter.Match(*entry) {
+			entries = append(entries, *entry)
+		}
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		add(p.parseLine(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't read %s: %w", path, err)
+	}
+	add(p.flush())
+	return entries, nil
+}
+
+func logPath(logsDir string, logName string) string {
+	return filepath.Join(logsDir, logName+logFileExt)
+}
+
+// parser groups the lines of a log into entries.
+type parser struct {
+	logName string
+	now     time.Time
+	// Entry being parsed, which may still get more lines
+	entry *Entry
+}
+
+func newParser(logName string) *parser {
+	return &parser{logName: logName, now: time.Now()}
+}
+
+// Adds [line] to the entries, and returns the previous entry if
+// [line] starts a new one.
+func (p *parser) parseLine(line string) *Entry {
+	entry, ok := p.parseRecord(line)
+	if !ok {
+		if p.entry == nil {
+			p.entry = &Entry{LogName: p.logName, Text: line}
+		} else {
+			p.entry.Text += "\n" + line
+		}
+		return nil
+	}
+	prev := p.entry
+	p.entry = &entry
+	return prev
+}
+
+// Returns the entry being parsed, if any.
+func (p *parser) flush() *Entry {
+	entry := p.entry
+	p.entry = nil
+	return entry
+}
+
+// Parses the start of a record, in either the plain or the json format.
+func (p *parser) parseRecord(line string) (Entry, bool) {
+	entry := Entry{LogName: p.logName, Text: line}
+	if strings.HasPrefix(line, "{") {
+		record := struct {
+			Level     string `json:"level"`
+			Timestamp string `json:"timestamp"`
+		}{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil || record.Level == "" {
+			return entry, false
+		}
+		entry.Level = strings.ToUpper(record.Level)
+		entry.Time, _ = time.Parse(jsonTimeLayout, record.Timestamp)
+		return entry, true
+	}
+	matches := plainRecordRegexp.FindStringSubmatch(line)
+	if matches == nil {
+		return entry, false
+	}
+	entry.Level = matches[2]
+	if t, err := time.ParseInLocation(plainTimeLayout, matches[1], time.Local); err == nil {
+		// the year is not logged, so assume the record is at most a year old
+		t = t.AddDate(p.now.Year(), 0, 0)
+		if t.After(p.now.Add(24 * time.Hour)) {
+			t = t.AddDate(-1, 0, 0)
+		}
+		entry.Time = t
+	}
+	return entry, true
+}
@@ -0,0 +1,166 @@
+package logs
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+const testLog = `[01-02|15:04:05.000] INFO <D Chain> chains/manager.go:100 creating chain
+[01-02|15:04:06.000] WARN <D Chain> chains/manager.go:101 slow block
+[01-02|15:04:07.000] ERROR <D Chain> chains/manager.go:102 chain failed
+goroutine 1 [running]:
+main.main()
+[01-02|15:04:08.000] DEBUG <D Chain> chains/manager.go:103 retrying
+`
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func appendFile(t *testing.T, path string, content string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestRead(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "D.log"), testLog)
+
+	entries, err := Read(dir, "D", Filter{MinLevel: logging.Verbo})
+	require.NoError(err)
+	require.Len(entries, 4)
+	require.Equal("D", entries[0].LogName)
+	require.Equal("INFO", entries[0].Level)
+	require.Equal(time.January, entries[0].Time.Month())
+	require.Equal(15, entries[0].Time.Hour())
+	// the stack trace belongs to the error record
+	require.Equal("ERROR", entries[2].Level)
+	require.Contains(entries[2].Text, "main.main()")
+
+	entries, err = Read(dir, "D", Filter{MinLevel: logging.Warn})
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.Equal("WARN", entries[0].Level)
+	require.Equal("ERROR", entries[1].Level)
+
+	entries, err = Read(dir, "D", Filter{MinLevel: logging.Verbo, Contains: "retrying"})
+	require.NoError(err)
+	require.Len(entries, 1)
+
+	since := entries[0].Time.Add(-time.Second)
+	entries, err = Read(dir, "D", Filter{MinLevel: logging.Verbo, Since: since, Until: since})
+	require.NoError(err)
+	require.Len(entries, 1)
+	require.Equal("ERROR", entries[0].Level)
+
+	_, err = Read(dir, "O", Filter{})
+	require.ErrorIs(err, ErrLogNotFound)
+}
+
+func TestReadJSON(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main.log"),
+		`{"level":"info","timestamp":"2023-01-02T15:04:05.000Z","logger":"main","msg":"starting node"}
+{"level":"error","timestamp":"2023-01-02T15:04:06.000Z","logger":"main","msg":"shutting down"}
+`)
+	entries, err := Read(dir, MainLogName, Filter{MinLevel: logging.Error})
+	require.NoError(err)
+	require.Len(entries, 1)
+	require.Equal("ERROR", entries[0].Level)
+	require.Equal(time.Date(2023, 1, 2, 15, 4, 6, 0, time.UTC), entries[0].Time.UTC())
+}
+
+func TestReadBackups(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "main-2023-01-02T15-04-06.000.log"),
+		"[01-02|15:04:06.000] INFO <main> second\n")
+	f, err := os.Create(filepath.Join(dir, "main-2023-01-02T15-04-05.000.log.gz"))
+	require.NoError(err)
+	gw := gzip.NewWriter(f)
+	_, err = gw.Write([]byte("[01-02|15:04:05.000] INFO <main> first\n"))
+	require.NoError(err)
+	require.NoError(gw.Close())
+	require.NoError(f.Close())
+	writeFile(t, filepath.Join(dir, "main.log"), "[01-02|15:04:07.000] INFO <main> third\n")
+
+	entries, err := Read(dir, MainLogName, Filter{MinLevel: logging.Verbo})
+	require.NoError(err)
+	require.Len(entries, 3)
+	require.Contains(entries[0].Text, "first")
+	require.Contains(entries[1].Text, "second")
+	require.Contains(entries[2].Text, "third")
+}
+
+func TestFindLogName(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "D.log"), testLog)
+
+	logName, err := FindLogName(dir, "D-Chain", "../D", "D")
+	require.NoError(err)
+	require.Equal("D", logName)
+
+	_, err = FindLogName(dir, "O")
+	require.ErrorIs(err, ErrLogNotFound)
+}
+
+func TestFollow(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.log")
+	writeFile(t, path, testLog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	entryCh := make(chan Entry, 10)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- Follow(ctx, dir, MainLogName, 2, Filter{MinLevel: logging.Verbo}, entryCh)
+	}()
+
+	next := func() Entry {
+		select {
+		case entry := <-entryCh:
+			return entry
+		case <-time.After(5 * time.Second):
+			require.FailNow("no log entry")
+			return Entry{}
+		}
+	}
+
+	// last records
+	require.Equal("ERROR", next().Level)
+	require.Equal("DEBUG", next().Level)
+
+	// new records
+	appendFile(t, path, "[01-02|15:04:09.000] INFO <main> appended\n")
+	require.Contains(next().Text, "appended")
+
+	// rotation: the old file gets a last record, then a new file is created
+	appendFile(t, path, "[01-02|15:04:10.000] INFO <main> before rotation\n")
+	require.NoError(os.Rename(path, filepath.Join(dir, "main-2023-01-02T15-04-10.000.log")))
+	writeFile(t, path, "[01-02|15:04:11.000] INFO <main> after rotation\n")
+	require.Contains(next().Text, "before rotation")
+	require.Contains(next().Text, "after rotation")
+
+	cancel()
+	require.NoError(<-errCh)
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package manifest implements a declarative description of a network,
+// and the computation of the changes needed to make a running network match it.
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrNoNodes               = errors.New("manifest must define at least one node")
+	ErrEmptyName             = errors.New("empty name")
+	ErrDuplicatedName        = errors.New("duplicated name")
+	ErrUnknownNode           = errors.New("unknown node")
+	ErrUnknownSubnet         = errors.New("unknown subnet")
+	ErrNoVMName              = errors.New("blockchain vm name is missing")
+	ErrNoGenesis             = errors.New("blockchain genesis is missing")
+	ErrValidatorNotElastic   = errors.New("permissionless validators require an elastic subnet")
+	ErrPermissionedValidator = errors.New("permissionless validator is also a subnet participant")
+)
+
+// Manifest describes the desired state of a network.
+type Manifest struct {
+	Nodes       []Node       `json:"nodes" yaml:"nodes"`
+	Subnets     []Subnet     `json:"subnets" yaml:"subnets"`
+	Blockchains []Blockchain `json:"blockchains" yaml:"blockchains"`
+}
+
+// Node describes a network node. Nodes are matched against the running
+// network by name. A node that already exists must have the given exec path
+// and flags, as they can't be changed in place.
+type Node struct {
+	Name string `json:"name" yaml:"name"`
+	// OdysseyGo binary path. If empty, the network default is used.
+	ExecPath string                 `json:"execPath" yaml:"execPath"`
+	Flags    map[string]interface{} `json:"flags" yaml:"flags"`
+}
+
+// Subnet describes a subnet and its validators.
+type Subnet struct {
+	// Name used to reference the subnet from the manifest blockchains.
+	// It is not recorded on chain.
+	Name string `json:"name" yaml:"name"`
+	// ID of an already existing subnet. If empty, the subnet is matched
+	// against the running network by the blockchains it hosts, or by its
+	// participants, and created if no match is found.
+	ID string `json:"id" yaml:"id"`
+	// Nodes validating the subnet.
+	Participants []string `json:"participants" yaml:"participants"`
+	// Subnet config, either file path or file contents.
+	Config                   string                    `json:"config" yaml:"config"`
+	Elastic                  *ElasticSubnet            `json:"elastic" yaml:"elastic"`
+	PermissionlessValidators []PermissionlessValidator `json:"permissionlessValidators" yaml:"permissionlessValidators"`
+}
+
+// ElasticSubnet holds the parameters to transform a subnet into an
+// elastic one. Durations are given in hours.
+type ElasticSubnet struct {
+	// Asset ID of an already transformed subnet. Needed to add new
+	// permissionless validators to it.
+	AssetID                   string `json:"assetID" yaml:"assetID"`
+	AssetName                 string `json:"assetName" yaml:"assetName"`
+	AssetSymbol               string `json:"assetSymbol" yaml:"assetSymbol"`
+	InitialSupply             uint64 `json:"initialSupply" yaml:"initialSupply"`
+	MaxSupply                 uint64 `json:"maxSupply" yaml:"maxSupply"`
+	MinConsumptionRate        uint64 `json:"minConsumptionRate" yaml:"minConsumptionRate"`
+	MaxConsumptionRate        uint64 `json:"maxConsumptionRate" yaml:"maxConsumptionRate"`
+	MinValidatorStake         uint64 `json:"minValidatorStake" yaml:"minValidatorStake"`
+	MaxValidatorStake         uint64 `json:"maxValidatorStake" yaml:"maxValidatorStake"`
+	MinValidatorStakeDuration uint64 `json:"minValidatorStakeDuration" yaml:"minValidatorStakeDuration"`
+	MaxValidatorStakeDuration uint64 `json:"maxValidatorStakeDuration" yaml:"maxValidatorStakeDuration"`
+	MinDelegatorStakeDuration uint64 `json:"minDelegatorStakeDuration" yaml:"minDelegatorStakeDuration"`
+	MaxDelegatorStakeDuration uint64 `json:"maxDelegatorStakeDuration" yaml:"maxDelegatorStakeDuration"`
+	MinDelegationFee          uint32 `json:"minDelegationFee" yaml:"minDelegationFee"`
+	MinDelegatorStake         uint64 `json:"minDelegatorStake" yaml:"minDelegatorStake"`
+	MaxValidatorWeightFactor  uint32 `json:"maxValidatorWeightFactor" yaml:"maxValidatorWeightFactor"`
+	UptimeRequirement         uint32 `json:"uptimeRequirement" yaml:"uptimeRequirement"`
+}
+
+// PermissionlessValidator describes a validator of an elastic subnet.
+// The stake duration is given in hours.
+type PermissionlessValidator struct {
+	NodeName      string `json:"nodeName" yaml:"nodeName"`
+	StakedAmount  uint64 `json:"stakedAmount" yaml:"stakedAmount"`
+	StakeDuration uint64 `json:"stakeDuration" yaml:"stakeDuration"`
+}
+
+// Blockchain describes a blockchain. Blockchains are matched against the
+// running network by VM name and subnet.
+type Blockchain struct {
+	VMName string `json:"vmName" yaml:"vmName"`
+	// Name of the manifest subnet the blockchain belongs to.
+	Subnet string `json:"subnet" yaml:"subnet"`
+	// Either file path or file contents.
+	Genesis string `json:"genesis" yaml:"genesis"`
+	// Either file path or file contents.
+	ChainConfig string `json:"chainConfig" yaml:"chainConfig"`
+	// Either file path or file contents.
+	NetworkUpgrade  string `json:"networkUpgrade" yaml:"networkUpgrade"`
+	BlockchainAlias string `json:"blockchainAlias" yaml:"blockchainAlias"`
+	// Per node chain config, either file path or file contents.
+	PerNodeChainConfig string `json:"perNodeChainConfig" yaml:"perNodeChainConfig"`
+}
+
+// Parse decodes a manifest in YAML or JSON format, and validates it.
+func Parse(manifestBytes []byte) (*Manifest, error) {
+	m := &Manifest{}
+	// JSON is a subset of YAML
+	if err := yaml.Unmarshal(manifestBytes, m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate returns an error if the manifest is not self consistent.
+func (m *Manifest) Validate() error {
+	if len(m.Nodes) == 0 {
+		return ErrNoNodes
+	}
+	nodeNames := set.Set[string]{}
+	for _, node := range m.Nodes {
+		if node.Name == "" {
+			return fmt.Errorf("node: %w", ErrEmptyName)
+		}
+		if nodeNames.Contains(node.Name) {
+			return fmt.Errorf("%w: node %q", ErrDuplicatedName, node.Name)
+		}
+		nodeNames.Add(node.Name)
+	}
+	subnetNames := set.Set[string]{}
+	for _, subnet := range m.Subnets {
+		if subnet.Name == "" {
+			return fmt.Errorf("subnet: %w", ErrEmptyName)
+		}
+		if subnetNames.Contains(subnet.Name) {
+			return fmt.Errorf("%w: subnet %q", ErrDuplicatedName, subnet.Name)
+		}
+		subnetNames.Add(subnet.Name)
+		for _, participant := range subnet.Participants {
+			if !nodeNames.Contains(participant) {
+				return fmt.Errorf("%w %q in participants of subnet %q", ErrUnknownNode, participant, subnet.Name)
+			}
+		}
+		if len(subnet.PermissionlessValidators) > 0 && subnet.Elastic == nil {
+			return fmt.Errorf("%w: subnet %q", ErrValidatorNotElastic, subnet.Name)
+		}
+		participants := set.Set[string]{}
+		participants.Add(subnet.Participants...)
+		for _, validator := range subnet.PermissionlessValidators {
+			if !nodeNames.Contains(validator.NodeName) {
+				return fmt.Errorf("%w %q in permissionless validators of subnet %q", ErrUnknownNode, validator.NodeName, subnet.Name)
+			}
+			if participants.Contains(validator.NodeName) {
+				return fmt.Errorf("%w: node %q, subnet %q", ErrPermissionedValidator, validator.NodeName, subnet.Name)
+			}
+		}
+	}
+	for _, blockchain := range m.Blockchains {
+		if blockchain.VMName == "" {
+			return ErrNoVMName
+		}
+		if blockchain.Genesis == "" {
+			return fmt.Errorf("%w: vm %q", ErrNoGenesis, blockchain.VMName)
+		}
+		if !subnetNames.Contains(blockchain.Subnet) {
+			return fmt.Errorf("%w %q for blockchain with vm %q", ErrUnknownSubnet, blockchain.Subnet, blockchain.VMName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `
+nodes:
+  - name: node1
+  - name: node2
+  - name: node3
+    flags:
+      log-level: debug
+subnets:
+  - name: s1
+    participants: [node1, node2]
+  - name: s2
+    elastic:
+      assetName: token
+      assetSymbol: TKN
+    permissionlessValidators:
+      - nodeName: node3
+        stakedAmount: 100
+        stakeDuration: 24
+blockchains:
+  - vmName: subnetevm
+    subnet: s1
+    genesis: "{}"
+`
+
+func TestParse(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Parse([]byte(testManifest))
+	require.NoError(err)
+	require.Len(m.Nodes, 3)
+	require.Equal("debug", m.Nodes[2].Flags["log-level"])
+	require.Len(m.Subnets, 2)
+	require.Equal([]string{"node1", "node2"}, m.Subnets[0].Participants)
+	require.NotNil(m.Subnets[1].Elastic)
+	require.Equal(uint64(24), m.Subnets[1].PermissionlessValidators[0].StakeDuration)
+	require.Len(m.Blockchains, 1)
+
+	// JSON is also accepted
+	m, err = Parse([]byte(`{"nodes":[{"name":"node1"}]}`))
+	require.NoError(err)
+	require.Len(m.Nodes, 1)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    string
+		expectedErr error
+	}{
+		{
+			name:        "no nodes",
+			manifest:    `subnets: [{name: s1}]`,
+			expectedErr: ErrNoNodes,
+		},
+		{
+			name:        "duplicated node",
+			manifest:    `nodes: [{name: node1}, {name: node1}]`,
+			expectedErr: ErrDuplicatedName,
+		},
+		{
+			name:        "unknown participant",
+			manifest:    `{nodes: [{name: node1}], subnets: [{name: s1, participants: [node2]}]}`,
+			expectedErr: ErrUnknownNode,
+		},
+		{
+			name:        "permissionless validator on permissioned subnet",
+			manifest:    `{nodes: [{name: node1}], subnets: [{name: s1, permissionlessValidators: [{nodeName: node1}]}]}`,
+			expectedErr: ErrValidatorNotElastic,
+		},
+		{
+			name:        "permissionless validator is a participant",
+			manifest:    `{nodes: [{name: node1}], subnets: [{name: s1, participants: [node1], elastic: {}, permissionlessValidators: [{nodeName: node1}]}]}`,
+			expectedErr: ErrPermissionedValidator,
+		},
+		{
+			name:        "unknown blockchain subnet",
+			manifest:    `{nodes: [{name: node1}], blockchains: [{vmName: vm, genesis: "{}", subnet: s1}]}`,
+			expectedErr: ErrUnknownSubnet,
+		},
+		{
+			name:        "no genesis",
+			manifest:    `{nodes: [{name: node1}], subnets: [{name: s1}], blockchains: [{vmName: vm, subnet: s1}]}`,
+			expectedErr: ErrNoGenesis,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.manifest))
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestDiffEmptyNetwork(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Parse([]byte(testManifest))
+	require.NoError(err)
+
+	clusterInfo := &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1", "node4"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Name: "node1"},
+			"node4": {Name: "node4"},
+		},
+	}
+	plan, err := Diff(m, clusterInfo)
+	require.NoError(err)
+
+	types := []ActionType{}
+	for _, action := range plan.Actions {
+		types = append(types, action.Type)
+	}
+	require.Equal([]ActionType{
+		RemoveNode,
+		AddNode,
+		AddNode,
+		CreateSubnet,
+		CreateSubnet,
+		TransformSubnet,
+		AddPermissionlessValidator,
+		CreateBlockchain,
+	}, types)
+	require.Equal("node4", plan.Actions[0].Node.Name)
+	require.Equal("node2", plan.Actions[1].Node.Name)
+	require.Equal("node3", plan.Actions[2].Node.Name)
+	// empty participants stand for all the nodes but the permissionless validators
+	require.Equal([]string{"node1", "node2"}, plan.Actions[4].Subnet.Participants)
+	require.Empty(plan.Actions[7].SubnetID)
+	require.Equal("s1", plan.Actions[7].Subnet.Name)
+}
+
+func TestDiffMatchingNetwork(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Parse([]byte(testManifest))
+	require.NoError(err)
+
+	clusterInfo := &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1", "node2", "node3"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Name: "node1"},
+			"node2": {Name: "node2"},
+			"node3": {Name: "node3", Flags: []byte(`{"log-level":"debug","http-port":9660}`)},
+		},
+		Subnets: map[string]*rpcpb.SubnetInfo{
+			// matched to s2 by participants
+			"subnetA": {
+				IsElastic:          true,
+				SubnetParticipants: &rpcpb.SubnetParticipants{NodeNames: []string{"node1", "node2", "node3"}},
+			},
+			// matched to s1 by its blockchain
+			"subnetB": {
+				SubnetParticipants: &rpcpb.SubnetParticipants{NodeNames: []string{"node1", "node2", "node3"}},
+			},
+		},
+		CustomChains: map[string]*rpcpb.CustomChainInfo{
+			"chain1": {ChainName: "subnetevm", SubnetId: "subnetB"},
+		},
+	}
+	plan, err := Diff(m, clusterInfo)
+	require.NoError(err)
+	require.Len(plan.Actions, 1)
+	require.Equal(RemoveSubnetValidators, plan.Actions[0].Type)
+	require.Equal("subnetB", plan.Actions[0].SubnetID)
+	require.Equal([]string{"node3"}, plan.Actions[0].NodeNames)
+
+	// once the validator is removed, there is nothing left to do
+	clusterInfo.Subnets["subnetB"].SubnetParticipants.NodeNames = []string{"node1", "node2"}
+	plan, err = Diff(m, clusterInfo)
+	require.NoError(err)
+	require.Empty(plan.Actions)
+}
+
+func TestDiffErrors(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Parse([]byte(`{nodes: [{name: node1}, {name: node2}], subnets: [{name: s1, id: subnetA, participants: [node1, node2]}]}`))
+	require.NoError(err)
+
+	clusterInfo := &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1", "node2"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Name: "node1"},
+			"node2": {Name: "node2"},
+		},
+	}
+	_, err = Diff(m, clusterInfo)
+	require.ErrorIs(err, ErrSubnetNotFound)
+
+	clusterInfo.Subnets = map[string]*rpcpb.SubnetInfo{
+		"subnetA": {SubnetParticipants: &rpcpb.SubnetParticipants{NodeNames: []string{"node1"}}},
+	}
+	_, err = Diff(m, clusterInfo)
+	require.ErrorIs(err, ErrCannotAddParticipant)
+
+	clusterInfo.Subnets["subnetA"].IsElastic = true
+	clusterInfo.Subnets["subnetA"].SubnetParticipants.NodeNames = []string{"node1", "node2"}
+	_, err = Diff(m, clusterInfo)
+	require.ErrorIs(err, ErrCannotRevertElastic)
+}
+
+func TestDiffNodeDrift(t *testing.T) {
+	require := require.New(t)
+
+	m, err := Parse([]byte(`
+nodes:
+  - name: node1
+    execPath: /tmp/odysseygo
+  - name: node2
+    flags:
+      log-level: debug
+      http-port: 9652
+`))
+	require.NoError(err)
+
+	clusterInfo := &rpcpb.ClusterInfo{
+		NodeNames: []string{"node1", "node2"},
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Name: "node1", ExecPath: "/tmp/odysseygo"},
+			"node2": {Name: "node2", Flags: []byte(`{"log-level":"debug","http-port":9652,"data-dir":"/tmp/node2"}`)},
+		},
+	}
+	plan, err := Diff(m, clusterInfo)
+	require.NoError(err)
+	require.Empty(plan.Actions)
+
+	clusterInfo.NodeInfos["node1"].ExecPath = "/tmp/other-odysseygo"
+	clusterInfo.NodeInfos["node2"].Flags = []byte(`{"log-level":"info"}`)
+	_, err = Diff(m, clusterInfo)
+	require.ErrorIs(err, ErrNodeDrift)
+	require.ErrorContains(err, "node1 (execPath); node2 (flag http-port, flag log-level)")
+}
@@ -0,0 +1,405 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"golang.org/x/exp/maps"
+)
+
+var (
+	ErrSubnetNotFound       = errors.New("subnet not found in network")
+	ErrCannotAddParticipant = errors.New("participants can't be added to an existing permissioned subnet")
+	ErrCannotRevertElastic  = errors.New("an elastic subnet can't be reverted to a permissioned one")
+	ErrNoAssetID            = errors.New("elastic subnet asset id is needed to add permissionless validators")
+	ErrNodeDrift            = errors.New("existing nodes differ from the manifest, and can't be changed in place")
+)
+
+type ActionType string
+
+// Actions are executed in the order their types are listed here.
+const (
+	RemoveSubnetValidators     ActionType = "remove-subnet-validators"
+	RemoveNode                 ActionType = "remove-node"
+	AddNode                    ActionType = "add-node"
+	CreateSubnet               ActionType = "create-subnet"
+	TransformSubnet            ActionType = "transform-subnet"
+	AddPermissionlessValidator ActionType = "add-permissionless-validator"
+	CreateBlockchain           ActionType = "create-blockchain"
+)
+
+// Action is a single change to be made to the network.
+type Action struct {
+	Type ActionType
+	// Set for node actions. Only [Node.Name] is set for [RemoveNode].
+	Node Node
+	// Set for subnet and validator actions.
+	Subnet Subnet
+	// ID of the subnet in the network. Empty if the subnet is created
+	// by a previous action of the same plan.
+	SubnetID string
+	// Set for [RemoveSubnetValidators].
+	NodeNames []string
+	// Set for [AddPermissionlessValidator].
+	Validator PermissionlessValidator
+	// Set for [CreateBlockchain].
+	Blockchain Blockchain
+}
+
+func (a Action) String() string {
+	subnet := a.Subnet.Name
+	if a.SubnetID != "" {
+		subnet = fmt.Sprintf("%s (%s)", a.Subnet.Name, a.SubnetID)
+	}
+	switch a.Type {
+	case RemoveNode, AddNode:
+		return fmt.Sprintf("%s %s", a.Type, a.Node.Name)
+	case CreateSubnet:
+		return fmt.Sprintf("%s %s with participants %v", a.Type, a.Subnet.Name, a.Subnet.Participants)
+	case RemoveSubnetValidators:
+		return fmt.Sprintf("%s %v from subnet %s", a.Type, a.NodeNames, subnet)
+	case TransformSubnet:
+		return fmt.Sprintf("%s %s into elastic subnet", a.Type, subnet)
+	case AddPermissionlessValidator:
+		return fmt.Sprintf("%s %s to subnet %s", a.Type, a.Validator.NodeName, subnet)
+	case CreateBlockchain:
+		return fmt.Sprintf("%s with vm %s on subnet %s", a.Type, a.Blockchain.VMName, subnet)
+	default:
+		return string(a.Type)
+	}
+}
+
+// Plan is the ordered list of actions that reconcile a network with a manifest.
+type Plan struct {
+	Actions []Action
+}
+
+// Returns the actions of the given type, keeping the plan order.
+func (p *Plan) ActionsOf(actionType ActionType) []Action {
+	actions := []Action{}
+	for _, action := range p.Actions {
+		if action.Type == actionType {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// Returns a human readable description of each action.
+func (p *Plan) Strings() []string {
+	strs := make([]string, 0, len(p.Actions))
+	for _, action := range p.Actions {
+		strs = append(strs, action.String())
+	}
+	return strs
+}
+
+// Diff computes the plan that makes the network described by [clusterInfo]
+// match [m].
+// Nodes are matched by name, and nodes not in the manifest are removed.
+// Existing nodes with a different exec path or flags are an error.
+// Subnets are matched by ID if given, otherwise by the VMs of the blockchains
+// they host, and lastly by their participants. Subnets and blockchains
+// not in the manifest are kept, as they can't be removed.
+func Diff(m *Manifest, clusterInfo *rpcpb.ClusterInfo) (*Plan, error) {
+	// nodes
+	nodeActions := []Action{}
+	manifestNodeNames := set.Set[string]{}
+	for _, node := range m.Nodes {
+		manifestNodeNames.Add(node.Name)
+	}
+	liveNodeNames := clusterInfo.GetNodeNames()
+	sort.Strings(liveNodeNames)
+	for _, nodeName := range liveNodeNames {
+		if !manifestNodeNames.Contains(nodeName) {
+			nodeActions = append(nodeActions, Action{Type: RemoveNode, Node: Node{Name: nodeName}})
+		}
+	}
+	driftedNodes := []string{}
+	for _, node := range m.Nodes {
+		nodeInfo, ok := clusterInfo.GetNodeInfos()[node.Name]
+		if !ok {
+			nodeActions = append(nodeActions, Action{Type: AddNode, Node: node})
+			continue
+		}
+		drift, err := getNodeDrift(node, nodeInfo)
+		if err != nil {
+			return nil, err
+		}
+		if len(drift) > 0 {
+			driftedNodes = append(driftedNodes, fmt.Sprintf("%s (%s)", node.Name, strings.Join(drift, ", ")))
+		}
+	}
+	if len(driftedNodes) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNodeDrift, strings.Join(driftedNodes, "; "))
+	}
+
+	// subnets
+	subnetIDs, err := matchSubnets(m, clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	validatorActions := []Action{}
+	createActions := []Action{}
+	transformActions := []Action{}
+	permissionlessActions := []Action{}
+	for _, subnet := range m.Subnets {
+		subnetID := subnetIDs[subnet.Name]
+		liveSubnet := clusterInfo.GetSubnets()[subnetID]
+		participants := getParticipants(m, subnet)
+		if subnetID == "" {
+			// participants are resolved, so they don't depend on the nodes at execution time
+			subnet.Participants = participants
+			createActions = append(createActions, Action{Type: CreateSubnet, Subnet: subnet})
+		}
+
+		liveParticipants := set.Set[string]{}
+		liveParticipants.Add(liveSubnet.GetSubnetParticipants().GetNodeNames()...)
+		desiredParticipants := set.Set[string]{}
+		desiredParticipants.Add(participants...)
+		for _, validator := range subnet.PermissionlessValidators {
+			desiredParticipants.Add(validator.NodeName)
+		}
+
+		if subnetID != "" {
+			for _, participant := range participants {
+				if !liveParticipants.Contains(participant) {
+					return nil, fmt.Errorf("%w: node %q, subnet %q", ErrCannotAddParticipant, participant, subnet.Name)
+				}
+			}
+			toRemove := []string{}
+			for participant := range liveParticipants {
+				if !desiredParticipants.Contains(participant) {
+					toRemove = append(toRemove, participant)
+				}
+			}
+			if len(toRemove) > 0 {
+				sort.Strings(toRemove)
+				validatorActions = append(validatorActions, Action{
+					Type:      RemoveSubnetValidators,
+					Subnet:    subnet,
+					SubnetID:  subnetID,
+					NodeNames: toRemove,
+				})
+			}
+		}
+
+		if subnet.Elastic == nil {
+			if liveSubnet.GetIsElastic() {
+				return nil, fmt.Errorf("%w: subnet %q", ErrCannotRevertElastic, subnet.Name)
+			}
+			continue
+		}
+		transform := !liveSubnet.GetIsElastic()
+		if transform {
+			transformActions = append(transformActions, Action{Type: TransformSubnet, Subnet: subnet, SubnetID: subnetID})
+		}
+		for _, validator := range subnet.PermissionlessValidators {
+			if liveParticipants.Contains(validator.NodeName) {
+				continue
+			}
+			if !transform && subnet.Elastic.AssetID == "" {
+				return nil, fmt.Errorf("%w: subnet %q", ErrNoAssetID, subnet.Name)
+			}
+			permissionlessActions = append(permissionlessActions, Action{
+				Type:      AddPermissionlessValidator,
+				Subnet:    subnet,
+				SubnetID:  subnetID,
+				Validator: validator,
+			})
+		}
+	}
+
+	// blockchains
+	blockchainActions := []Action{}
+	subnetsByName := map[string]Subnet{}
+	for _, subnet := range m.Subnets {
+		subnetsByName[subnet.Name] = subnet
+	}
+	for _, blockchain := range m.Blockchains {
+		subnetID := subnetIDs[blockchain.Subnet]
+		if subnetID != "" && hostsVM(clusterInfo, subnetID, blockchain.VMName) {
+			continue
+		}
+		blockchainActions = append(blockchainActions, Action{
+			Type:       CreateBlockchain,
+			Subnet:     subnetsByName[blockchain.Subnet],
+			SubnetID:   subnetID,
+			Blockchain: blockchain,
+		})
+	}
+
+	// validators are removed before their nodes are
+	plan := &Plan{}
+	plan.Actions = append(plan.Actions, validatorActions...)
+	plan.Actions = append(plan.Actions, nodeActions...)
+	plan.Actions = append(plan.Actions, createActions...)
+	plan.Actions = append(plan.Actions, transformActions...)
+	plan.Actions = append(plan.Actions, permissionlessActions...)
+	plan.Actions = append(plan.Actions, blockchainActions...)
+	return plan, nil
+}
+
+// Returns the exec path and flags of [node] that differ from the running node
+// [nodeInfo]. Flags not in the manifest are not compared, as the running node
+// also has the network and runner ones.
+func getNodeDrift(node Node, nodeInfo *rpcpb.NodeInfo) ([]string, error) {
+	drift := []string{}
+	if node.ExecPath != "" && node.ExecPath != nodeInfo.GetExecPath() {
+		drift = append(drift, "execPath")
+	}
+	liveFlags := map[string]interface{}{}
+	if len(nodeInfo.GetFlags()) > 0 {
+		if err := json.Unmarshal(nodeInfo.GetFlags(), &liveFlags); err != nil {
+			return nil, fmt.Errorf("couldn't decode the flags of node %q: %w", node.Name, err)
+		}
+	}
+	flagNames := maps.Keys(node.Flags)
+	sort.Strings(flagNames)
+	for _, flagName := range flagNames {
+		liveValue, ok := liveFlags[flagName]
+		if !ok {
+			drift = append(drift, "flag "+flagName)
+			continue
+		}
+		// compare the JSON encodings, as YAML and JSON decode numbers differently
+		value, err := json.Marshal(node.Flags[flagName])
+		if err != nil {
+			return nil, err
+		}
+		liveValueBytes, err := json.Marshal(liveValue)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(value, liveValueBytes) {
+			drift = append(drift, "flag "+flagName)
+		}
+	}
+	return drift, nil
+}
+
+// Returns the permissioned participants of [subnet]. As on subnet creation,
+// an empty participants list stands for all the nodes, except for the
+// permissionless validators.
+func getParticipants(m *Manifest, subnet Subnet) []string {
+	if len(subnet.Participants) > 0 {
+		return subnet.Participants
+	}
+	permissionless := set.Set[string]{}
+	for _, validator := range subnet.PermissionlessValidators {
+		permissionless.Add(validator.NodeName)
+	}
+	participants := make([]string, 0, len(m.Nodes))
+	for _, node := range m.Nodes {
+		if !permissionless.Contains(node.Name) {
+			participants = append(participants, node.Name)
+		}
+	}
+	return participants
+}
+
+// Maps each manifest subnet name to the ID of its matching subnet in
+// [clusterInfo], or to the empty string if there is no match.
+func matchSubnets(m *Manifest, clusterInfo *rpcpb.ClusterInfo) (map[string]string, error) {
+	subnetIDs := map[string]string{}
+	matched := set.Set[string]{}
+
+	// by ID
+	for _, subnet := range m.Subnets {
+		if subnet.ID == "" {
+			continue
+		}
+		if _, ok := clusterInfo.GetSubnets()[subnet.ID]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrSubnetNotFound, subnet.ID)
+		}
+		subnetIDs[subnet.Name] = subnet.ID
+		matched.Add(subnet.ID)
+	}
+
+	liveSubnetIDs := maps.Keys(clusterInfo.GetSubnets())
+	sort.Strings(liveSubnetIDs)
+
+	// by hosted blockchains
+	for _, subnet := range m.Subnets {
+		if _, ok := subnetIDs[subnet.Name]; ok {
+			continue
+		}
+		for _, blockchain := range m.Blockchains {
+			if blockchain.Subnet != subnet.Name {
+				continue
+			}
+			for _, subnetID := range liveSubnetIDs {
+				if !matched.Contains(subnetID) && hostsVM(clusterInfo, subnetID, blockchain.VMName) {
+					subnetIDs[subnet.Name] = subnetID
+					matched.Add(subnetID)
+					break
+				}
+			}
+			if _, ok := subnetIDs[subnet.Name]; ok {
+				break
+			}
+		}
+	}
+
+	// by participants
+	for _, subnet := range m.Subnets {
+		if _, ok := subnetIDs[subnet.Name]; ok {
+			continue
+		}
+		// live participants must include all the permissioned ones, and
+		// may include some of the permissionless validators
+		participants := set.Set[string]{}
+		participants.Add(getParticipants(m, subnet)...)
+		validators := set.Set[string]{}
+		validators.Union(participants)
+		for _, validator := range subnet.PermissionlessValidators {
+			validators.Add(validator.NodeName)
+		}
+		for _, subnetID := range liveSubnetIDs {
+			if matched.Contains(subnetID) {
+				continue
+			}
+			liveParticipants := set.Set[string]{}
+			liveParticipants.Add(clusterInfo.GetSubnets()[subnetID].GetSubnetParticipants().GetNodeNames()...)
+			permissioned := set.Set[string]{}
+			permissioned.Union(liveParticipants)
+			permissioned.Difference(validators)
+			if permissioned.Len() == 0 && isSubset(participants, liveParticipants) {
+				subnetIDs[subnet.Name] = subnetID
+				matched.Add(subnetID)
+				break
+			}
+		}
+	}
+
+	return subnetIDs, nil
+}
+
+// Returns true if the subnet [subnetID] hosts a blockchain of the VM [vmName].
+func hostsVM(clusterInfo *rpcpb.ClusterInfo, subnetID string, vmName string) bool {
+	for _, chainInfo := range clusterInfo.GetCustomChains() {
+		if chainInfo.SubnetId == subnetID && chainInfo.ChainName == vmName {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if all the elements of [s] are in [other].
+func isSubset(s set.Set[string], other set.Set[string]) bool {
+	for elt := range s {
+		if !other.Contains(elt) {
+			return false
+		}
+	}
+	return true
+}
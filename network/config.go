@@ -6,16 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
-	"time"
 
 	"github.com/DioneProtocol/odyssey-network-runner/network/node"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
-	"github.com/DioneProtocol/odysseygo/genesis"
 	"github.com/DioneProtocol/odysseygo/ids"
-	"github.com/DioneProtocol/odysseygo/utils/constants"
-	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/units"
-	"golang.org/x/exp/maps"
 )
 
 var dChainConfig map[string]interface{}
@@ -47,6 +42,13 @@ type AddrAndBalance struct {
 	Balance *big.Int
 }
 
+// Account holds the addresses of a key on the A- and O-chains, and on the
+// D-chain
+type Account struct {
+	Addr    ids.ShortID
+	EthAddr ids.ShortID
+}
+
 // Config that defines a network when it is created.
 type Config struct {
 	// Must not be empty
@@ -75,6 +77,9 @@ type Config struct {
 	UpgradeConfigFiles map[string]string `json:"upgradeConfigFiles"`
 	// Subnet config files to use per default, if not specified in node config
 	SubnetConfigFiles map[string]string `json:"subnetConfigFiles"`
+	// If true, the p2p traffic between nodes goes through a proxy
+	// that can inject partitions, latency and packet loss
+	FaultInjection bool `json:"faultInjection,omitempty"`
 }
 
 // Validate returns an error if this config is invalid
@@ -108,104 +113,3 @@ func (c *Config) Validate() error {
 	}
 	return nil
 }
-
-// Return a genesis JSON where:
-// The nodes in [genesisVdrs] are validators.
-// The D-Chain and A-Chain balances are given by
-// [dChainBalances] and [aChainBalances].
-// Note that many of the genesis fields (i.e. reward addresses)
-// are randomly generated or hard-coded.
-func NewOdysseyGoGenesis(
-	networkID uint32,
-	aChainBalances []AddrAndBalance,
-	dChainBalances []AddrAndBalance,
-	genesisVdrs []ids.NodeID,
-) ([]byte, error) {
-	switch networkID {
-	case constants.TestnetID, constants.MainnetID, constants.LocalID:
-		return nil, errors.New("network ID can't be mainnet, testnet or local network ID")
-	}
-	switch {
-	case len(genesisVdrs) == 0:
-		return nil, errors.New("no genesis validators provided")
-	case len(aChainBalances)+len(dChainBalances) == 0:
-		return nil, errors.New("no genesis balances given")
-	}
-
-	// Address that controls stake doesn't matter -- generate it randomly
-	genesisVdrStakeAddr, _ := address.Format(
-		"A",
-		constants.GetHRP(networkID),
-		ids.GenerateTestShortID().Bytes(),
-	)
-	config := genesis.UnparsedConfig{
-		NetworkID: networkID,
-		Allocations: []genesis.UnparsedAllocation{
-			{
-				ETHAddr:       "0x0000000000000000000000000000000000000000",
-				DIONEAddr:     genesisVdrStakeAddr, // Owner doesn't matter
-				InitialAmount: 0,
-				UnlockSchedule: []genesis.LockedAmount{ // Provides stake to validators
-					{
-						Amount: uint64(len(genesisVdrs)) * validatorStake,
-					},
-				},
-			},
-		},
-		StartTime:                  uint64(time.Now().Unix()),
-		InitialStakedFunds:         []string{genesisVdrStakeAddr},
-		InitialStakeDuration:       31_536_000, // 1 year
-		InitialStakeDurationOffset: 5_400,      // 90 minutes
-		Message:                    "hello world",
-	}
-
-	for _, aChainBal := range aChainBalances {
-		aChainAddr, _ := address.Format("A", constants.GetHRP(networkID), aChainBal.Addr[:])
-		config.Allocations = append(
-			config.Allocations,
-			genesis.UnparsedAllocation{
-				ETHAddr:       "0x0000000000000000000000000000000000000000",
-				DIONEAddr:     aChainAddr,
-				InitialAmount: aChainBal.Balance.Uint64(),
-				UnlockSchedule: []genesis.LockedAmount{
-					{
-						Amount:   validatorStake * uint64(len(genesisVdrs)), // Stake
-						Locktime: uint64(time.Now().Add(7 * 24 * time.Hour).Unix()),
-					},
-				},
-			},
-		)
-	}
-
-	// Set initial D-Chain balances.
-	dChainAllocs := map[string]interface{}{}
-	for _, dChainBal := range dChainBalances {
-		addrHex := fmt.Sprintf("0x%s", dChainBal.Addr.Hex())
-		balHex := fmt.Sprintf("0x%x", dChainBal.Balance)
-		dChainAllocs[addrHex] = map[string]interface{}{
-			"balance": balHex,
-		}
-	}
-	// avoid modifying original dChainConfig
-	localDChainConfig := maps.Clone(dChainConfig)
-	localDChainConfig["alloc"] = dChainAllocs
-	dChainConfigBytes, _ := json.Marshal(localDChainConfig)
-	config.DChainGenesis = string(dChainConfigBytes)
-
-	// Set initial validators.
-	// Give staking rewards to random address.
-	rewardAddr, _ := address.Format("A", constants.GetHRP(networkID), ids.GenerateTestShortID().Bytes())
-	for _, genesisVdr := range genesisVdrs {
-		config.InitialStakers = append(
-			config.InitialStakers,
-			genesis.UnparsedStaker{
-				NodeID:        genesisVdr,
-				RewardAddress: rewardAddr,
-				DelegationFee: 10_000,
-			},
-		)
-	}
-
-	// TODO add validation (from OdysseyGo's function validateConfig?)
-	return json.Marshal(config)
-}
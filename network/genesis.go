@@ -2,10 +2,22 @@ package network
 
 import (
 	_ "embed"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	coreth_params "github.com/DioneProtocol/coreth/params"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"golang.org/x/exp/maps"
 )
 
 //go:embed default/genesis.json
@@ -45,3 +57,219 @@ func LoadLocalGenesis() (map[string]interface{}, error) {
 	genesisMap["dChainGenesis"] = string(configBytes)
 	return genesisMap, nil
 }
+
+// Funds of the EWOQ key in the local genesis. They are kept in the generated
+// genesis, as the runner issues its txs with the EWOQ key.
+const (
+	ewoqAChainBalance = 300 * units.MegaDione
+	ewoqOChainBalance = 20 * units.MegaDione
+)
+
+const (
+	defaultInitialStakeDuration       = 31_536_000 // 1 year
+	defaultInitialStakeDurationOffset = 5_400      // 90 minutes
+	// Of the initial stakers of NewOdysseyGoGenesis, in parts per million
+	defaultGenesisDelegationFee = 10_000
+)
+
+// GenesisConfig defines the genesis generated by NewGenesis.
+type GenesisConfig struct {
+	// Must not be the mainnet, testnet or local network ID
+	NetworkID uint32
+	// Balances on the A-chain, in nDIONE
+	AChainBalances []AddrAndBalance
+	// Unlocked balances on the O-chain, in nDIONE
+	OChainBalances []AddrAndBalance
+	// Balances on the D-chain, in wei
+	DChainBalances []AddrAndBalance
+	// Must not be empty
+	InitialStakers []GenesisStaker
+	// In seconds. Defaults to 1 year if zero
+	InitialStakeDuration uint64
+	// In seconds, between the ends of the stakes of consecutive initial
+	// stakers. Defaults to 90 minutes if zero
+	InitialStakeDurationOffset uint64
+}
+
+// GenesisStaker is a node that validates the primary network from genesis.
+type GenesisStaker struct {
+	NodeID ids.NodeID
+	// In parts per million of the delegator rewards
+	DelegationFee uint32
+}
+
+// NewGenesis returns a genesis JSON for [genesisConfig], validated the same
+// way the nodes do. Besides the given balances, the EWOQ key is funded on
+// all the chains, and the D-chain keeps the allocations of the local genesis.
+// Staking rewards go to the EWOQ key.
+func NewGenesis(genesisConfig GenesisConfig) ([]byte, error) {
+	networkID := genesisConfig.NetworkID
+	switch networkID {
+	case 0:
+		return nil, errors.New("network ID not given")
+	case constants.TestnetID, constants.MainnetID, constants.LocalID:
+		return nil, errors.New("network ID can't be mainnet, testnet or local network ID")
+	}
+	if len(genesisConfig.InitialStakers) == 0 {
+		return nil, errors.New("no initial stakers given")
+	}
+	hrp := constants.GetHRP(networkID)
+	ewoqAddr := genesis.EWOQKey.PublicKey().Address()
+	ewoqAChainAddr, err := address.Format("A", hrp, ewoqAddr[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Address that controls stake doesn't matter -- generate it randomly
+	stakeAddr, err := address.Format("A", hrp, ids.GenerateTestShortID().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	config := genesis.UnparsedConfig{
+		NetworkID: networkID,
+		Allocations: []genesis.UnparsedAllocation{
+			{
+				ETHAddr:       "0x0000000000000000000000000000000000000000",
+				DIONEAddr:     stakeAddr,
+				InitialAmount: 0,
+				UnlockSchedule: []genesis.LockedAmount{ // Provides stake to validators
+					{
+						Amount: uint64(len(genesisConfig.InitialStakers)) * validatorStake,
+					},
+				},
+			},
+		},
+		StartTime:                  uint64(time.Now().Unix()),
+		InitialStakedFunds:         []string{stakeAddr},
+		InitialStakeDuration:       genesisConfig.InitialStakeDuration,
+		InitialStakeDurationOffset: genesisConfig.InitialStakeDurationOffset,
+		Message:                    "hello world",
+	}
+	if config.InitialStakeDuration == 0 {
+		config.InitialStakeDuration = defaultInitialStakeDuration
+	}
+	if config.InitialStakeDurationOffset == 0 {
+		config.InitialStakeDurationOffset = defaultInitialStakeDurationOffset
+	}
+
+	// A-chain and O-chain balances of the same address go in one allocation
+	aChainBalances := map[ids.ShortID]uint64{ewoqAddr: ewoqAChainBalance}
+	oChainBalances := map[ids.ShortID]uint64{ewoqAddr: ewoqOChainBalance}
+	addrs := []ids.ShortID{ewoqAddr}
+	for _, balances := range []struct {
+		chain    string
+		balances []AddrAndBalance
+		byAddr   map[ids.ShortID]uint64
+	}{
+		{chain: "A", balances: genesisConfig.AChainBalances, byAddr: aChainBalances},
+		{chain: "O", balances: genesisConfig.OChainBalances, byAddr: oChainBalances},
+	} {
+		for _, bal := range balances.balances {
+			if bal.Balance == nil || bal.Balance.Sign() < 0 || !bal.Balance.IsUint64() {
+				return nil, fmt.Errorf("invalid %s-chain balance %v for address %s", balances.chain, bal.Balance, bal.Addr)
+			}
+			_, inA := aChainBalances[bal.Addr]
+			_, inO := oChainBalances[bal.Addr]
+			if !inA && !inO {
+				addrs = append(addrs, bal.Addr)
+			}
+			balances.byAddr[bal.Addr] = bal.Balance.Uint64()
+		}
+	}
+	for _, addr := range addrs {
+		dioneAddr, err := address.Format("A", hrp, addr[:])
+		if err != nil {
+			return nil, err
+		}
+		allocation := genesis.UnparsedAllocation{
+			ETHAddr:        "0x0000000000000000000000000000000000000000",
+			DIONEAddr:      dioneAddr,
+			InitialAmount:  aChainBalances[addr],
+			UnlockSchedule: []genesis.LockedAmount{},
+		}
+		if oChainBalances[addr] > 0 {
+			allocation.UnlockSchedule = append(allocation.UnlockSchedule, genesis.LockedAmount{Amount: oChainBalances[addr]})
+		}
+		config.Allocations = append(config.Allocations, allocation)
+	}
+
+	// Add the D-Chain balances to the ones of the local genesis.
+	dChainAllocs := map[string]interface{}{}
+	if localDChainAllocs, ok := dChainConfig["alloc"].(map[string]interface{}); ok {
+		for addrHex, alloc := range localDChainAllocs {
+			dChainAllocs[strings.ToLower(strings.TrimPrefix(addrHex, "0x"))] = alloc
+		}
+	}
+	for _, dChainBal := range genesisConfig.DChainBalances {
+		if dChainBal.Balance == nil || dChainBal.Balance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid D-chain balance %v for address 0x%s", dChainBal.Balance, dChainBal.Addr.Hex())
+		}
+		dChainAllocs[dChainBal.Addr.Hex()] = map[string]interface{}{
+			"balance": fmt.Sprintf("0x%x", dChainBal.Balance),
+		}
+	}
+	// avoid modifying original dChainConfig
+	localDChainConfig := maps.Clone(dChainConfig)
+	localDChainConfig["alloc"] = dChainAllocs
+	dChainConfigBytes, err := json.Marshal(localDChainConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.DChainGenesis = string(dChainConfigBytes)
+
+	stakers := set.Set[ids.NodeID]{}
+	for _, staker := range genesisConfig.InitialStakers {
+		if stakers.Contains(staker.NodeID) {
+			return nil, fmt.Errorf("repeated initial staker %s", staker.NodeID)
+		}
+		stakers.Add(staker.NodeID)
+		if staker.DelegationFee > reward.PercentDenominator {
+			return nil, fmt.Errorf("delegation fee %d of initial staker %s is above %d", staker.DelegationFee, staker.NodeID, reward.PercentDenominator)
+		}
+		config.InitialStakers = append(
+			config.InitialStakers,
+			genesis.UnparsedStaker{
+				NodeID:        staker.NodeID,
+				RewardAddress: ewoqAChainAddr,
+				DelegationFee: staker.DelegationFee,
+			},
+		)
+	}
+
+	genesisJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	stakingConfig := genesis.GetStakingConfig(networkID)
+	if _, _, _, err := genesis.FromFlag(networkID, base64.StdEncoding.EncodeToString(genesisJSON), &stakingConfig); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+	return genesisJSON, nil
+}
+
+// NewOdysseyGoGenesis returns a genesis JSON built by NewGenesis, where the
+// nodes in [genesisVdrs] are the initial stakers, with a delegation fee
+// of 1%, and [aChainBalances] and [dChainBalances] are funded.
+func NewOdysseyGoGenesis(
+	networkID uint32,
+	aChainBalances []AddrAndBalance,
+	dChainBalances []AddrAndBalance,
+	genesisVdrs []ids.NodeID,
+) ([]byte, error) {
+	if len(aChainBalances)+len(dChainBalances) == 0 {
+		return nil, errors.New("no genesis balances given")
+	}
+	initialStakers := make([]GenesisStaker, 0, len(genesisVdrs))
+	for _, genesisVdr := range genesisVdrs {
+		initialStakers = append(initialStakers, GenesisStaker{
+			NodeID:        genesisVdr,
+			DelegationFee: defaultGenesisDelegationFee,
+		})
+	}
+	return NewGenesis(GenesisConfig{
+		NetworkID:      networkID,
+		AChainBalances: aChainBalances,
+		DChainBalances: dChainBalances,
+		InitialStakers: initialStakers,
+	})
+}
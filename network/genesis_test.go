@@ -0,0 +1,175 @@
+package network_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/utils"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenesis(t *testing.T) {
+	require := require.New(t)
+
+	aChainAddr := ids.GenerateTestShortID()
+	dChainAddr := ids.GenerateTestShortID()
+	nodeIDs := []ids.NodeID{ids.GenerateTestNodeID(), ids.GenerateTestNodeID()}
+	genesisJSON, err := network.NewGenesis(network.GenesisConfig{
+		NetworkID: 1338,
+		AChainBalances: []network.AddrAndBalance{
+			{Addr: aChainAddr, Balance: big.NewInt(1000)},
+		},
+		OChainBalances: []network.AddrAndBalance{
+			{Addr: aChainAddr, Balance: big.NewInt(2000)},
+		},
+		DChainBalances: []network.AddrAndBalance{
+			{Addr: dChainAddr, Balance: new(big.Int).Lsh(big.NewInt(1), 80)},
+		},
+		InitialStakers: []network.GenesisStaker{
+			{NodeID: nodeIDs[0], DelegationFee: 20_000},
+			{NodeID: nodeIDs[1], DelegationFee: 30_000},
+		},
+		InitialStakeDuration:       7_200,
+		InitialStakeDurationOffset: 60,
+	})
+	require.NoError(err)
+	networkID, err := utils.NetworkIDFromGenesis(genesisJSON)
+	require.NoError(err)
+	require.Equal(uint32(1338), networkID)
+
+	config := genesis.UnparsedConfig{}
+	require.NoError(json.Unmarshal(genesisJSON, &config))
+	require.Equal(uint64(7_200), config.InitialStakeDuration)
+	require.Equal(uint64(60), config.InitialStakeDurationOffset)
+	require.Len(config.InitialStakers, 2)
+	require.Equal(nodeIDs[0], config.InitialStakers[0].NodeID)
+	require.Equal(uint32(20_000), config.InitialStakers[0].DelegationFee)
+	require.Equal(uint32(30_000), config.InitialStakers[1].DelegationFee)
+
+	allocations := map[string]genesis.UnparsedAllocation{}
+	for _, allocation := range config.Allocations {
+		allocations[allocation.DIONEAddr] = allocation
+	}
+	dioneAddr, err := address.Format("A", constants.GetHRP(1338), aChainAddr[:])
+	require.NoError(err)
+	require.Contains(allocations, dioneAddr)
+	require.Equal(uint64(1000), allocations[dioneAddr].InitialAmount)
+	require.Equal([]genesis.LockedAmount{{Amount: 2000}}, allocations[dioneAddr].UnlockSchedule)
+	ewoqAddr := genesis.EWOQKey.PublicKey().Address()
+	ewoqDioneAddr, err := address.Format("A", constants.GetHRP(1338), ewoqAddr[:])
+	require.NoError(err)
+	require.Contains(allocations, ewoqDioneAddr)
+
+	dChainGenesis := map[string]interface{}{}
+	require.NoError(json.Unmarshal([]byte(config.DChainGenesis), &dChainGenesis))
+	dChainAllocs, ok := dChainGenesis["alloc"].(map[string]interface{})
+	require.True(ok)
+	require.Equal(map[string]interface{}{"balance": "0x100000000000000000000"}, dChainAllocs[dChainAddr.Hex()])
+	require.Contains(dChainAllocs, "8db97c7cece249c2b98bdc0226cc4c2a57bf52fc")
+}
+
+func TestNewGenesisValidation(t *testing.T) {
+	staker := network.GenesisStaker{NodeID: ids.GenerateTestNodeID()}
+	tests := []struct {
+		name        string
+		config      network.GenesisConfig
+		expectedErr string
+	}{
+		{
+			name:        "no network ID",
+			config:      network.GenesisConfig{InitialStakers: []network.GenesisStaker{staker}},
+			expectedErr: "network ID not given",
+		},
+		{
+			name:        "local network ID",
+			config:      network.GenesisConfig{NetworkID: constants.LocalID, InitialStakers: []network.GenesisStaker{staker}},
+			expectedErr: "can't be mainnet, testnet or local",
+		},
+		{
+			name:        "no stakers",
+			config:      network.GenesisConfig{NetworkID: 1338},
+			expectedErr: "no initial stakers",
+		},
+		{
+			name:        "repeated staker",
+			config:      network.GenesisConfig{NetworkID: 1338, InitialStakers: []network.GenesisStaker{staker, staker}},
+			expectedErr: "repeated initial staker",
+		},
+		{
+			name: "delegation fee too high",
+			config: network.GenesisConfig{
+				NetworkID:      1338,
+				InitialStakers: []network.GenesisStaker{{NodeID: staker.NodeID, DelegationFee: 1_000_001}},
+			},
+			expectedErr: "delegation fee",
+		},
+		{
+			name: "negative balance",
+			config: network.GenesisConfig{
+				NetworkID:      1338,
+				AChainBalances: []network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: big.NewInt(-1)}},
+				InitialStakers: []network.GenesisStaker{staker},
+			},
+			expectedErr: "invalid A-chain balance",
+		},
+		{
+			name: "stake duration too long",
+			config: network.GenesisConfig{
+				NetworkID:            1338,
+				InitialStakers:       []network.GenesisStaker{staker},
+				InitialStakeDuration: 2 * 31_536_000,
+			},
+			expectedErr: "invalid genesis",
+		},
+		{
+			name: "stake duration offset too long",
+			config: network.GenesisConfig{
+				NetworkID:                  1338,
+				InitialStakers:             []network.GenesisStaker{staker, {NodeID: ids.GenerateTestNodeID()}},
+				InitialStakeDuration:       60,
+				InitialStakeDurationOffset: 120,
+			},
+			expectedErr: "invalid genesis",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := network.NewGenesis(tt.config)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestNewOdysseyGoGenesis(t *testing.T) {
+	require := require.New(t)
+
+	nodeIDs := []ids.NodeID{ids.GenerateTestNodeID(), ids.GenerateTestNodeID()}
+	genesisJSON, err := network.NewOdysseyGoGenesis(
+		1338,
+		[]network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: big.NewInt(1)}},
+		nil,
+		nodeIDs,
+	)
+	require.NoError(err)
+	config := genesis.UnparsedConfig{}
+	require.NoError(json.Unmarshal(genesisJSON, &config))
+	require.Len(config.InitialStakers, 2)
+	for i, staker := range config.InitialStakers {
+		require.Equal(nodeIDs[i], staker.NodeID)
+		require.Equal(uint32(10_000), staker.DelegationFee)
+	}
+
+	// validated as by NewGenesis
+	_, err = network.NewOdysseyGoGenesis(constants.LocalID, []network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: big.NewInt(1)}}, nil, nodeIDs)
+	require.ErrorContains(err, "can't be mainnet, testnet or local")
+	_, err = network.NewOdysseyGoGenesis(1338, nil, nil, nodeIDs)
+	require.ErrorContains(err, "no genesis balances given")
+	_, err = network.NewOdysseyGoGenesis(1338, []network.AddrAndBalance{{Addr: ids.GenerateTestShortID(), Balance: big.NewInt(-1)}}, nil, nodeIDs)
+	require.ErrorContains(err, "invalid A-chain balance")
+}
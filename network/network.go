@@ -13,6 +13,9 @@ var (
 	ErrUndefined    = errors.New("undefined network")
 	ErrStopped      = errors.New("network stopped")
 	ErrNodeNotFound = errors.New("node not found in network")
+	// Returned by the fault injection methods if the network was not created
+	// with [Config.FaultInjection] set
+	ErrFaultInjectionDisabled = errors.New("fault injection is not enabled for the network")
 )
 
 type PermissionlessValidatorSpec struct {
@@ -24,6 +27,53 @@ type PermissionlessValidatorSpec struct {
 	StakeDuration time.Duration
 }
 
+// PermissionlessDelegatorSpec is a delegation to a validator of an elastic
+// subnet
+type PermissionlessDelegatorSpec struct {
+	SubnetID string
+	// Staked asset. The asset of the elastic subnet if empty
+	AssetID      string
+	NodeName     string
+	StakedAmount uint64
+	// As soon as possible if zero
+	StartTime time.Time
+	// Until the end of the validation if zero
+	StakeDuration time.Duration
+}
+
+// PrimaryDelegatorSpec is a delegation of DIONE to a primary network validator
+type PrimaryDelegatorSpec struct {
+	NodeName     string
+	StakedAmount uint64
+	// As soon as possible if zero
+	StartTime time.Time
+	// Until the end of the validation if zero
+	StakeDuration time.Duration
+}
+
+// FundSpec is a transfer from the EWOQ key
+type FundSpec struct {
+	// Chain prefixed bech32 address on the A- or O-chain, or hex address on
+	// the D-chain
+	Address string
+	// A-chain asset to send. DIONE if empty
+	AssetID string
+	Amount  uint64
+}
+
+// CrossChainTransferSpec moves funds of a key between the A-, O- and D-chains
+type CrossChainTransferSpec struct {
+	// "A", "O" or "D"
+	SourceChain      string
+	DestinationChain string
+	// Asset to move. DIONE if empty
+	AssetID string
+	Amount  uint64
+	// "PrivateKey-" prefixed key that owns the funds on the source chain, and
+	// gets them on the destination chain. The EWOQ key if empty
+	PrivateKey string
+}
+
 type ElasticSubnetSpec struct {
 	SubnetID                  *string
 	AssetName                 string
@@ -65,6 +115,24 @@ type BlockchainSpec struct {
 	PerNodeChainConfig map[string][]byte
 }
 
+type NodeEventType string
+
+const (
+	// The node process exited without being stopped by the network.
+	NodeCrashed NodeEventType = "crashed"
+	// The node process was relaunched by its restart policy.
+	NodeRelaunched NodeEventType = "relaunched"
+)
+
+// NodeEvent is a change of a node process that was not requested
+// through the network, such as a crash.
+type NodeEvent struct {
+	Type     NodeEventType
+	NodeName string
+	// Exit code of a crashed node process
+	ExitCode int
+}
+
 // Network is an abstraction of an Odyssey network
 type Network interface {
 	// Returns nil if all the nodes in the network are healthy.
@@ -75,6 +143,8 @@ type Network interface {
 	// Returns ErrStopped if Stop() was previously called.
 	Stop(context.Context) error
 	// Start a new node with the given config.
+	// If the config names a node to clone the DB from, that node is
+	// paused while its DB is copied.
 	// Returns ErrStopped if Stop() was previously called.
 	AddNode(node.Config) (node.Node, error)
 	// Stop the node with this name.
@@ -96,10 +166,15 @@ type Network interface {
 	// Returns the names of all nodes in this network.
 	// Returns ErrStopped if Stop() was previously called.
 	GetNodeNames() ([]string, error)
-	// Save network snapshot
+	// Save network snapshot, with a description and labels
 	// Network is stopped in order to do a safe preservation
 	// Returns the full local path to the snapshot dir
-	SaveSnapshot(context.Context, string) (string, error)
+	SaveSnapshot(context.Context, string, string, map[string]string) (string, error)
+	// Save network snapshot, with a description and labels
+	// Nodes are stopped in order to do a safe preservation, and then restarted
+	// with the same config. Returns once they are healthy.
+	// Returns the full local path to the snapshot dir
+	SaveSnapshotAndContinue(context.Context, string, string, map[string]string) (string, error)
 	// Remove network snapshot
 	RemoveSnapshot(string) error
 	// Get name of available snapshots
@@ -116,8 +191,39 @@ type Network interface {
 	TransformSubnet(context.Context, []ElasticSubnetSpec) ([]ids.ID, []ids.ID, error)
 	// Add a validator into an elastic subnet
 	AddPermissionlessValidators(context.Context, []PermissionlessValidatorSpec) error
+	// Add a delegator to a validator of an elastic subnet, and return the tx IDs.
+	// The amounts are checked against the staking rules of the subnet
+	AddPermissionlessDelegators(context.Context, []PermissionlessDelegatorSpec) ([]ids.ID, error)
+	// Add a delegator to a primary network validator, and return the tx IDs.
+	// The amounts are checked against the staking rules of the network
+	AddPrimaryDelegators(context.Context, []PrimaryDelegatorSpec) ([]ids.ID, error)
 	// Remove a validator from a subnet
 	RemoveSubnetValidators(context.Context, []RemoveSubnetValidatorSpec) error
 	// Get the elastic subnet tx id for the given subnet id
 	GetElasticSubnetID(context.Context, ids.ID) (ids.ID, error)
+	// Fund each of the given accounts with the given nDIONE amount on the
+	// A-, O- and D-chains, from the EWOQ key
+	FundAccounts(context.Context, []Account, uint64) error
+	// Send an asset to an address on any of the A-, O- and D-chains, from the
+	// EWOQ key, and return the ID of the tx that delivers it
+	Fund(context.Context, FundSpec) (string, error)
+	// Export an asset from a chain and import it into another, and return the
+	// IDs of the export and import txs
+	CrossChainTransfer(context.Context, CrossChainTransferSpec) (ids.ID, ids.ID, error)
+	// Split the nodes into groups that can't connect to each other.
+	// Nodes not included in any group form an extra group.
+	// Returns ErrFaultInjectionDisabled if fault injection is not enabled.
+	PartitionNodes(context.Context, [][]string) error
+	// Remove all the partitions.
+	// Returns ErrFaultInjectionDisabled if fault injection is not enabled.
+	HealPartitions(context.Context) error
+	// Set the delay, and its jitter, of the traffic sent from a node to another.
+	// Returns ErrFaultInjectionDisabled if fault injection is not enabled.
+	SetLinkLatency(ctx context.Context, from string, to string, delay time.Duration, jitter time.Duration) error
+	// Set the packet loss rate of the traffic sent from a node to another.
+	// Returns ErrFaultInjectionDisabled if fault injection is not enabled.
+	SetLinkLoss(ctx context.Context, from string, to string, loss float64) error
+	// Returns a channel that receives the node events. Events are dropped
+	// if the channel is not drained.
+	NodeEvents() <-chan NodeEvent
 }
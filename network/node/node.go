@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/DioneProtocol/odyssey-network-runner/api"
 	"github.com/DioneProtocol/odyssey-network-runner/network/node/status"
@@ -57,6 +58,69 @@ type Node interface {
 	GetFlag(string) (string, error)
 	// Return this node's paused status
 	GetPaused() bool
+	// Return the number of times the node process was relaunched
+	// by its restart policy
+	GetRestartCount() uint32
+	// Return the exit code of the last unexpected exit of the node
+	// process, or 0 if it never exited unexpectedly
+	GetLastExitCode() int
+	// Return the last resource usage samples of the node process,
+	// oldest first
+	GetResourceUsage() []ResourceUsage
+}
+
+// ResourceUsage of a node process and its descendants, such as VM plugins
+type ResourceUsage struct {
+	Time time.Time
+	// Percentage of a CPU core used since the previous sample
+	CPUPercent float64
+	// Resident memory
+	RSSBytes   uint64
+	NumFDs     int32
+	NumThreads int32
+	// Bytes read and written by the live processes since they started
+	ReadBytes  uint64
+	WriteBytes uint64
+	// Number of processes: the node and its descendants
+	NumProcesses int32
+}
+
+type RestartPolicyType string
+
+const (
+	// The node process is not relaunched.
+	RestartNever RestartPolicyType = "never"
+	// The node process is relaunched if it exits with a non zero code.
+	RestartOnFailure RestartPolicyType = "on-failure"
+	// The node process is relaunched whatever its exit code.
+	RestartAlways RestartPolicyType = "always"
+)
+
+// RestartPolicy defines if a node process that exits without being
+// stopped by the network is relaunched.
+type RestartPolicy struct {
+	// Defaults to [RestartNever]
+	Type RestartPolicyType `json:"type,omitempty"`
+	// Max number of relaunches. Zero means no limit.
+	MaxRetries uint32 `json:"maxRetries,omitempty"`
+	// Wait before the first relaunch, doubled on each following one.
+	// Defaults to 1 second.
+	Backoff time.Duration `json:"backoff,omitempty"`
+	// Upper bound of the wait before a relaunch. Defaults to 1 minute.
+	MaxBackoff time.Duration `json:"maxBackoff,omitempty"`
+}
+
+// Validate returns an error if this policy is invalid
+func (p *RestartPolicy) Validate() error {
+	switch p.Type {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return fmt.Errorf("unknown restart policy %q", p.Type)
+	}
+	if p.MaxBackoff != 0 && p.Backoff > p.MaxBackoff {
+		return errors.New("restart backoff is greater than max backoff")
+	}
+	return nil
 }
 
 // Config encapsulates an odysseygo configuration
@@ -95,6 +159,11 @@ type Config struct {
 	RedirectStdout bool `json:"redirectStdout"`
 	// If non-nil, direct this node's Stderr to os.Stderr
 	RedirectStderr bool `json:"redirectStderr"`
+	// Defines if the node process is relaunched when it exits unexpectedly
+	RestartPolicy RestartPolicy `json:"restartPolicy"`
+	// If not empty, the DB of the node is seeded with a copy of the DB of
+	// this existing node when the node is added. Not kept afterwards.
+	CloneDBFrom string `json:"-"`
 }
 
 // Validate returns an error if this config is invalid
@@ -104,9 +173,11 @@ func (c *Config) Validate(expectedNetworkID uint32) error {
 		return errors.New("staking key not given")
 	case c.StakingCert == "":
 		return errors.New("staking cert not given")
-	default:
-		return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 	}
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return err
+	}
+	return validateConfigFile([]byte(c.ConfigFile), expectedNetworkID)
 }
 
 // Returns an error if config file [configFile] is invalid.
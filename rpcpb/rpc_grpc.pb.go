@@ -115,6 +115,8 @@ const (
 	ControlService_CreateBlockchains_FullMethodName          = "/rpcpb.ControlService/CreateBlockchains"
 	ControlService_TransformElasticSubnets_FullMethodName    = "/rpcpb.ControlService/TransformElasticSubnets"
 	ControlService_AddPermissionlessValidator_FullMethodName = "/rpcpb.ControlService/AddPermissionlessValidator"
+	ControlService_AddPermissionlessDelegator_FullMethodName = "/rpcpb.ControlService/AddPermissionlessDelegator"
+	ControlService_AddPrimaryDelegator_FullMethodName        = "/rpcpb.ControlService/AddPrimaryDelegator"
 	ControlService_RemoveSubnetValidator_FullMethodName      = "/rpcpb.ControlService/RemoveSubnetValidator"
 	ControlService_CreateSubnets_FullMethodName              = "/rpcpb.ControlService/CreateSubnets"
 	ControlService_Health_FullMethodName                     = "/rpcpb.ControlService/Health"
@@ -134,6 +136,26 @@ const (
 	ControlService_LoadSnapshot_FullMethodName               = "/rpcpb.ControlService/LoadSnapshot"
 	ControlService_RemoveSnapshot_FullMethodName             = "/rpcpb.ControlService/RemoveSnapshot"
 	ControlService_GetSnapshotNames_FullMethodName           = "/rpcpb.ControlService/GetSnapshotNames"
+	ControlService_GetSnapshotInfo_FullMethodName            = "/rpcpb.ControlService/GetSnapshotInfo"
+	ControlService_ListSnapshots_FullMethodName              = "/rpcpb.ControlService/ListSnapshots"
+	ControlService_ListNetworks_FullMethodName               = "/rpcpb.ControlService/ListNetworks"
+	ControlService_ApplyManifest_FullMethodName              = "/rpcpb.ControlService/ApplyManifest"
+	ControlService_PartitionNodes_FullMethodName             = "/rpcpb.ControlService/PartitionNodes"
+	ControlService_HealPartitions_FullMethodName             = "/rpcpb.ControlService/HealPartitions"
+	ControlService_SetLinkLatency_FullMethodName             = "/rpcpb.ControlService/SetLinkLatency"
+	ControlService_SetLinkLoss_FullMethodName                = "/rpcpb.ControlService/SetLinkLoss"
+	ControlService_StartChaos_FullMethodName                 = "/rpcpb.ControlService/StartChaos"
+	ControlService_StopChaos_FullMethodName                  = "/rpcpb.ControlService/StopChaos"
+	ControlService_GetChaosTimeline_FullMethodName           = "/rpcpb.ControlService/GetChaosTimeline"
+	ControlService_StreamEvents_FullMethodName               = "/rpcpb.ControlService/StreamEvents"
+	ControlService_GetNodeLogs_FullMethodName                = "/rpcpb.ControlService/GetNodeLogs"
+	ControlService_TailLogs_FullMethodName                   = "/rpcpb.ControlService/TailLogs"
+	ControlService_ExportSnapshot_FullMethodName             = "/rpcpb.ControlService/ExportSnapshot"
+	ControlService_ImportSnapshot_FullMethodName             = "/rpcpb.ControlService/ImportSnapshot"
+	ControlService_Prune_FullMethodName                      = "/rpcpb.ControlService/Prune"
+	ControlService_MigrateSnapshot_FullMethodName            = "/rpcpb.ControlService/MigrateSnapshot"
+	ControlService_Fund_FullMethodName                       = "/rpcpb.ControlService/Fund"
+	ControlService_CrossChainTransfer_FullMethodName         = "/rpcpb.ControlService/CrossChainTransfer"
 )
 
 // ControlServiceClient is the client API for ControlService service.
@@ -145,6 +167,8 @@ type ControlServiceClient interface {
 	CreateBlockchains(ctx context.Context, in *CreateBlockchainsRequest, opts ...grpc.CallOption) (*CreateBlockchainsResponse, error)
 	TransformElasticSubnets(ctx context.Context, in *TransformElasticSubnetsRequest, opts ...grpc.CallOption) (*TransformElasticSubnetsResponse, error)
 	AddPermissionlessValidator(ctx context.Context, in *AddPermissionlessValidatorRequest, opts ...grpc.CallOption) (*AddPermissionlessValidatorResponse, error)
+	AddPermissionlessDelegator(ctx context.Context, in *AddPermissionlessDelegatorRequest, opts ...grpc.CallOption) (*AddPermissionlessDelegatorResponse, error)
+	AddPrimaryDelegator(ctx context.Context, in *AddPrimaryDelegatorRequest, opts ...grpc.CallOption) (*AddPrimaryDelegatorResponse, error)
 	RemoveSubnetValidator(ctx context.Context, in *RemoveSubnetValidatorRequest, opts ...grpc.CallOption) (*RemoveSubnetValidatorResponse, error)
 	CreateSubnets(ctx context.Context, in *CreateSubnetsRequest, opts ...grpc.CallOption) (*CreateSubnetsResponse, error)
 	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
@@ -164,6 +188,26 @@ type ControlServiceClient interface {
 	LoadSnapshot(ctx context.Context, in *LoadSnapshotRequest, opts ...grpc.CallOption) (*LoadSnapshotResponse, error)
 	RemoveSnapshot(ctx context.Context, in *RemoveSnapshotRequest, opts ...grpc.CallOption) (*RemoveSnapshotResponse, error)
 	GetSnapshotNames(ctx context.Context, in *GetSnapshotNamesRequest, opts ...grpc.CallOption) (*GetSnapshotNamesResponse, error)
+	GetSnapshotInfo(ctx context.Context, in *GetSnapshotInfoRequest, opts ...grpc.CallOption) (*GetSnapshotInfoResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error)
+	ApplyManifest(ctx context.Context, in *ApplyManifestRequest, opts ...grpc.CallOption) (*ApplyManifestResponse, error)
+	PartitionNodes(ctx context.Context, in *PartitionNodesRequest, opts ...grpc.CallOption) (*PartitionNodesResponse, error)
+	HealPartitions(ctx context.Context, in *HealPartitionsRequest, opts ...grpc.CallOption) (*HealPartitionsResponse, error)
+	SetLinkLatency(ctx context.Context, in *SetLinkLatencyRequest, opts ...grpc.CallOption) (*SetLinkLatencyResponse, error)
+	SetLinkLoss(ctx context.Context, in *SetLinkLossRequest, opts ...grpc.CallOption) (*SetLinkLossResponse, error)
+	StartChaos(ctx context.Context, in *StartChaosRequest, opts ...grpc.CallOption) (*StartChaosResponse, error)
+	StopChaos(ctx context.Context, in *StopChaosRequest, opts ...grpc.CallOption) (*StopChaosResponse, error)
+	GetChaosTimeline(ctx context.Context, in *GetChaosTimelineRequest, opts ...grpc.CallOption) (*GetChaosTimelineResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ControlService_StreamEventsClient, error)
+	GetNodeLogs(ctx context.Context, in *GetNodeLogsRequest, opts ...grpc.CallOption) (*GetNodeLogsResponse, error)
+	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (ControlService_TailLogsClient, error)
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (ControlService_ExportSnapshotClient, error)
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (ControlService_ImportSnapshotClient, error)
+	Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error)
+	MigrateSnapshot(ctx context.Context, in *MigrateSnapshotRequest, opts ...grpc.CallOption) (*MigrateSnapshotResponse, error)
+	Fund(ctx context.Context, in *FundRequest, opts ...grpc.CallOption) (*FundResponse, error)
+	CrossChainTransfer(ctx context.Context, in *CrossChainTransferRequest, opts ...grpc.CallOption) (*CrossChainTransferResponse, error)
 }
 
 type controlServiceClient struct {
@@ -224,6 +268,26 @@ func (c *controlServiceClient) AddPermissionlessValidator(ctx context.Context, i
 	return out, nil
 }
 
+func (c *controlServiceClient) AddPermissionlessDelegator(ctx context.Context, in *AddPermissionlessDelegatorRequest, opts ...grpc.CallOption) (*AddPermissionlessDelegatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPermissionlessDelegatorResponse)
+	err := c.cc.Invoke(ctx, ControlService_AddPermissionlessDelegator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) AddPrimaryDelegator(ctx context.Context, in *AddPrimaryDelegatorRequest, opts ...grpc.CallOption) (*AddPrimaryDelegatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPrimaryDelegatorResponse)
+	err := c.cc.Invoke(ctx, ControlService_AddPrimaryDelegator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlServiceClient) RemoveSubnetValidator(ctx context.Context, in *RemoveSubnetValidatorRequest, opts ...grpc.CallOption) (*RemoveSubnetValidatorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveSubnetValidatorResponse)
@@ -437,6 +501,300 @@ func (c *controlServiceClient) GetSnapshotNames(ctx context.Context, in *GetSnap
 	return out, nil
 }
 
+func (c *controlServiceClient) GetSnapshotInfo(ctx context.Context, in *GetSnapshotInfoRequest, opts ...grpc.CallOption) (*GetSnapshotInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSnapshotInfoResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetSnapshotInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, ControlService_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworksResponse)
+	err := c.cc.Invoke(ctx, ControlService_ListNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ApplyManifest(ctx context.Context, in *ApplyManifestRequest, opts ...grpc.CallOption) (*ApplyManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyManifestResponse)
+	err := c.cc.Invoke(ctx, ControlService_ApplyManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) PartitionNodes(ctx context.Context, in *PartitionNodesRequest, opts ...grpc.CallOption) (*PartitionNodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PartitionNodesResponse)
+	err := c.cc.Invoke(ctx, ControlService_PartitionNodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) HealPartitions(ctx context.Context, in *HealPartitionsRequest, opts ...grpc.CallOption) (*HealPartitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealPartitionsResponse)
+	err := c.cc.Invoke(ctx, ControlService_HealPartitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) SetLinkLatency(ctx context.Context, in *SetLinkLatencyRequest, opts ...grpc.CallOption) (*SetLinkLatencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLinkLatencyResponse)
+	err := c.cc.Invoke(ctx, ControlService_SetLinkLatency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) SetLinkLoss(ctx context.Context, in *SetLinkLossRequest, opts ...grpc.CallOption) (*SetLinkLossResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLinkLossResponse)
+	err := c.cc.Invoke(ctx, ControlService_SetLinkLoss_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) StartChaos(ctx context.Context, in *StartChaosRequest, opts ...grpc.CallOption) (*StartChaosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartChaosResponse)
+	err := c.cc.Invoke(ctx, ControlService_StartChaos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) StopChaos(ctx context.Context, in *StopChaosRequest, opts ...grpc.CallOption) (*StopChaosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopChaosResponse)
+	err := c.cc.Invoke(ctx, ControlService_StopChaos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GetChaosTimeline(ctx context.Context, in *GetChaosTimelineRequest, opts ...grpc.CallOption) (*GetChaosTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChaosTimelineResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetChaosTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ControlService_StreamEventsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[1], ControlService_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceStreamEventsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControlService_StreamEventsClient interface {
+	Recv() (*StreamEventsResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceStreamEventsClient) Recv() (*StreamEventsResponse, error) {
+	m := new(StreamEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServiceClient) GetNodeLogs(ctx context.Context, in *GetNodeLogsRequest, opts ...grpc.CallOption) (*GetNodeLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNodeLogsResponse)
+	err := c.cc.Invoke(ctx, ControlService_GetNodeLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (ControlService_TailLogsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[2], ControlService_TailLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceTailLogsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControlService_TailLogsClient interface {
+	Recv() (*TailLogsResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceTailLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceTailLogsClient) Recv() (*TailLogsResponse, error) {
+	m := new(TailLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServiceClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (ControlService_ExportSnapshotClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[3], ControlService_ExportSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceExportSnapshotClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControlService_ExportSnapshotClient interface {
+	Recv() (*ExportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceExportSnapshotClient) Recv() (*ExportSnapshotResponse, error) {
+	m := new(ExportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServiceClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (ControlService_ImportSnapshotClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[4], ControlService_ImportSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceImportSnapshotClient{ClientStream: stream}
+	return x, nil
+}
+
+type ControlService_ImportSnapshotClient interface {
+	Send(*ImportSnapshotRequest) error
+	CloseAndRecv() (*ImportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceImportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceImportSnapshotClient) Send(m *ImportSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *controlServiceImportSnapshotClient) CloseAndRecv() (*ImportSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controlServiceClient) Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PruneResponse)
+	err := c.cc.Invoke(ctx, ControlService_Prune_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) MigrateSnapshot(ctx context.Context, in *MigrateSnapshotRequest, opts ...grpc.CallOption) (*MigrateSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateSnapshotResponse)
+	err := c.cc.Invoke(ctx, ControlService_MigrateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Fund(ctx context.Context, in *FundRequest, opts ...grpc.CallOption) (*FundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FundResponse)
+	err := c.cc.Invoke(ctx, ControlService_Fund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) CrossChainTransfer(ctx context.Context, in *CrossChainTransferRequest, opts ...grpc.CallOption) (*CrossChainTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CrossChainTransferResponse)
+	err := c.cc.Invoke(ctx, ControlService_CrossChainTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServiceServer is the server API for ControlService service.
 // All implementations must embed UnimplementedControlServiceServer
 // for forward compatibility
@@ -446,6 +804,8 @@ type ControlServiceServer interface {
 	CreateBlockchains(context.Context, *CreateBlockchainsRequest) (*CreateBlockchainsResponse, error)
 	TransformElasticSubnets(context.Context, *TransformElasticSubnetsRequest) (*TransformElasticSubnetsResponse, error)
 	AddPermissionlessValidator(context.Context, *AddPermissionlessValidatorRequest) (*AddPermissionlessValidatorResponse, error)
+	AddPermissionlessDelegator(context.Context, *AddPermissionlessDelegatorRequest) (*AddPermissionlessDelegatorResponse, error)
+	AddPrimaryDelegator(context.Context, *AddPrimaryDelegatorRequest) (*AddPrimaryDelegatorResponse, error)
 	RemoveSubnetValidator(context.Context, *RemoveSubnetValidatorRequest) (*RemoveSubnetValidatorResponse, error)
 	CreateSubnets(context.Context, *CreateSubnetsRequest) (*CreateSubnetsResponse, error)
 	Health(context.Context, *HealthRequest) (*HealthResponse, error)
@@ -465,6 +825,26 @@ type ControlServiceServer interface {
 	LoadSnapshot(context.Context, *LoadSnapshotRequest) (*LoadSnapshotResponse, error)
 	RemoveSnapshot(context.Context, *RemoveSnapshotRequest) (*RemoveSnapshotResponse, error)
 	GetSnapshotNames(context.Context, *GetSnapshotNamesRequest) (*GetSnapshotNamesResponse, error)
+	GetSnapshotInfo(context.Context, *GetSnapshotInfoRequest) (*GetSnapshotInfoResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error)
+	ApplyManifest(context.Context, *ApplyManifestRequest) (*ApplyManifestResponse, error)
+	PartitionNodes(context.Context, *PartitionNodesRequest) (*PartitionNodesResponse, error)
+	HealPartitions(context.Context, *HealPartitionsRequest) (*HealPartitionsResponse, error)
+	SetLinkLatency(context.Context, *SetLinkLatencyRequest) (*SetLinkLatencyResponse, error)
+	SetLinkLoss(context.Context, *SetLinkLossRequest) (*SetLinkLossResponse, error)
+	StartChaos(context.Context, *StartChaosRequest) (*StartChaosResponse, error)
+	StopChaos(context.Context, *StopChaosRequest) (*StopChaosResponse, error)
+	GetChaosTimeline(context.Context, *GetChaosTimelineRequest) (*GetChaosTimelineResponse, error)
+	StreamEvents(*StreamEventsRequest, ControlService_StreamEventsServer) error
+	GetNodeLogs(context.Context, *GetNodeLogsRequest) (*GetNodeLogsResponse, error)
+	TailLogs(*TailLogsRequest, ControlService_TailLogsServer) error
+	ExportSnapshot(*ExportSnapshotRequest, ControlService_ExportSnapshotServer) error
+	ImportSnapshot(ControlService_ImportSnapshotServer) error
+	Prune(context.Context, *PruneRequest) (*PruneResponse, error)
+	MigrateSnapshot(context.Context, *MigrateSnapshotRequest) (*MigrateSnapshotResponse, error)
+	Fund(context.Context, *FundRequest) (*FundResponse, error)
+	CrossChainTransfer(context.Context, *CrossChainTransferRequest) (*CrossChainTransferResponse, error)
 	mustEmbedUnimplementedControlServiceServer()
 }
 
@@ -487,6 +867,12 @@ func (UnimplementedControlServiceServer) TransformElasticSubnets(context.Context
 func (UnimplementedControlServiceServer) AddPermissionlessValidator(context.Context, *AddPermissionlessValidatorRequest) (*AddPermissionlessValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPermissionlessValidator not implemented")
 }
+func (UnimplementedControlServiceServer) AddPermissionlessDelegator(context.Context, *AddPermissionlessDelegatorRequest) (*AddPermissionlessDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPermissionlessDelegator not implemented")
+}
+func (UnimplementedControlServiceServer) AddPrimaryDelegator(context.Context, *AddPrimaryDelegatorRequest) (*AddPrimaryDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPrimaryDelegator not implemented")
+}
 func (UnimplementedControlServiceServer) RemoveSubnetValidator(context.Context, *RemoveSubnetValidatorRequest) (*RemoveSubnetValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubnetValidator not implemented")
 }
@@ -544,6 +930,66 @@ func (UnimplementedControlServiceServer) RemoveSnapshot(context.Context, *Remove
 func (UnimplementedControlServiceServer) GetSnapshotNames(context.Context, *GetSnapshotNamesRequest) (*GetSnapshotNamesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotNames not implemented")
 }
+func (UnimplementedControlServiceServer) GetSnapshotInfo(context.Context, *GetSnapshotInfoRequest) (*GetSnapshotInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotInfo not implemented")
+}
+func (UnimplementedControlServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedControlServiceServer) ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworks not implemented")
+}
+func (UnimplementedControlServiceServer) ApplyManifest(context.Context, *ApplyManifestRequest) (*ApplyManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyManifest not implemented")
+}
+func (UnimplementedControlServiceServer) PartitionNodes(context.Context, *PartitionNodesRequest) (*PartitionNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartitionNodes not implemented")
+}
+func (UnimplementedControlServiceServer) HealPartitions(context.Context, *HealPartitionsRequest) (*HealPartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealPartitions not implemented")
+}
+func (UnimplementedControlServiceServer) SetLinkLatency(context.Context, *SetLinkLatencyRequest) (*SetLinkLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkLatency not implemented")
+}
+func (UnimplementedControlServiceServer) SetLinkLoss(context.Context, *SetLinkLossRequest) (*SetLinkLossResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkLoss not implemented")
+}
+func (UnimplementedControlServiceServer) StartChaos(context.Context, *StartChaosRequest) (*StartChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartChaos not implemented")
+}
+func (UnimplementedControlServiceServer) StopChaos(context.Context, *StopChaosRequest) (*StopChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopChaos not implemented")
+}
+func (UnimplementedControlServiceServer) GetChaosTimeline(context.Context, *GetChaosTimelineRequest) (*GetChaosTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChaosTimeline not implemented")
+}
+func (UnimplementedControlServiceServer) StreamEvents(*StreamEventsRequest, ControlService_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedControlServiceServer) GetNodeLogs(context.Context, *GetNodeLogsRequest) (*GetNodeLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeLogs not implemented")
+}
+func (UnimplementedControlServiceServer) TailLogs(*TailLogsRequest, ControlService_TailLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
+func (UnimplementedControlServiceServer) ExportSnapshot(*ExportSnapshotRequest, ControlService_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedControlServiceServer) ImportSnapshot(ControlService_ImportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
+func (UnimplementedControlServiceServer) Prune(context.Context, *PruneRequest) (*PruneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+}
+func (UnimplementedControlServiceServer) MigrateSnapshot(context.Context, *MigrateSnapshotRequest) (*MigrateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSnapshot not implemented")
+}
+func (UnimplementedControlServiceServer) Fund(context.Context, *FundRequest) (*FundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fund not implemented")
+}
+func (UnimplementedControlServiceServer) CrossChainTransfer(context.Context, *CrossChainTransferRequest) (*CrossChainTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainTransfer not implemented")
+}
 func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
 
 // UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -647,35 +1093,71 @@ func _ControlService_AddPermissionlessValidator_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlService_RemoveSubnetValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSubnetValidatorRequest)
+func _ControlService_AddPermissionlessDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPermissionlessDelegatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServiceServer).RemoveSubnetValidator(ctx, in)
+		return srv.(ControlServiceServer).AddPermissionlessDelegator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlService_RemoveSubnetValidator_FullMethodName,
+		FullMethod: ControlService_AddPermissionlessDelegator_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServiceServer).RemoveSubnetValidator(ctx, req.(*RemoveSubnetValidatorRequest))
+		return srv.(ControlServiceServer).AddPermissionlessDelegator(ctx, req.(*AddPermissionlessDelegatorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlService_CreateSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSubnetsRequest)
+func _ControlService_AddPrimaryDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPrimaryDelegatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlServiceServer).CreateSubnets(ctx, in)
+		return srv.(ControlServiceServer).AddPrimaryDelegator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlService_CreateSubnets_FullMethodName,
+		FullMethod: ControlService_AddPrimaryDelegator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).AddPrimaryDelegator(ctx, req.(*AddPrimaryDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_RemoveSubnetValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSubnetValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RemoveSubnetValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_RemoveSubnetValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RemoveSubnetValidator(ctx, req.(*RemoveSubnetValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_CreateSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).CreateSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_CreateSubnets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ControlServiceServer).CreateSubnets(ctx, req.(*CreateSubnetsRequest))
@@ -992,6 +1474,383 @@ func _ControlService_GetSnapshotNames_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlService_GetSnapshotInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetSnapshotInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetSnapshotInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetSnapshotInfo(ctx, req.(*GetSnapshotInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ListNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ListNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ListNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ListNetworks(ctx, req.(*ListNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ApplyManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ApplyManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_ApplyManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ApplyManifest(ctx, req.(*ApplyManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_PartitionNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).PartitionNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_PartitionNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).PartitionNodes(ctx, req.(*PartitionNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_HealPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).HealPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_HealPartitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).HealPartitions(ctx, req.(*HealPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_SetLinkLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).SetLinkLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_SetLinkLatency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).SetLinkLatency(ctx, req.(*SetLinkLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_SetLinkLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkLossRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).SetLinkLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_SetLinkLoss_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).SetLinkLoss(ctx, req.(*SetLinkLossRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_StartChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).StartChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_StartChaos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).StartChaos(ctx, req.(*StartChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_StopChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).StopChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_StopChaos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).StopChaos(ctx, req.(*StopChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GetChaosTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChaosTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetChaosTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetChaosTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetChaosTimeline(ctx, req.(*GetChaosTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServiceServer).StreamEvents(m, &controlServiceStreamEventsServer{ServerStream: stream})
+}
+
+type ControlService_StreamEventsServer interface {
+	Send(*StreamEventsResponse) error
+	grpc.ServerStream
+}
+
+type controlServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceStreamEventsServer) Send(m *StreamEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ControlService_GetNodeLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetNodeLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_GetNodeLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetNodeLogs(ctx, req.(*GetNodeLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServiceServer).TailLogs(m, &controlServiceTailLogsServer{ServerStream: stream})
+}
+
+type ControlService_TailLogsServer interface {
+	Send(*TailLogsResponse) error
+	grpc.ServerStream
+}
+
+type controlServiceTailLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceTailLogsServer) Send(m *TailLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ControlService_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServiceServer).ExportSnapshot(m, &controlServiceExportSnapshotServer{ServerStream: stream})
+}
+
+type ControlService_ExportSnapshotServer interface {
+	Send(*ExportSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type controlServiceExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceExportSnapshotServer) Send(m *ExportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ControlService_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ControlServiceServer).ImportSnapshot(&controlServiceImportSnapshotServer{ServerStream: stream})
+}
+
+type ControlService_ImportSnapshotServer interface {
+	SendAndClose(*ImportSnapshotResponse) error
+	Recv() (*ImportSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type controlServiceImportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceImportSnapshotServer) SendAndClose(m *ImportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *controlServiceImportSnapshotServer) Recv() (*ImportSnapshotRequest, error) {
+	m := new(ImportSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ControlService_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Prune_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Prune(ctx, req.(*PruneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_MigrateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).MigrateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_MigrateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).MigrateSnapshot(ctx, req.(*MigrateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Fund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Fund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Fund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Fund(ctx, req.(*FundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_CrossChainTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrossChainTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).CrossChainTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_CrossChainTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).CrossChainTransfer(ctx, req.(*CrossChainTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1019,6 +1878,14 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddPermissionlessValidator",
 			Handler:    _ControlService_AddPermissionlessValidator_Handler,
 		},
+		{
+			MethodName: "AddPermissionlessDelegator",
+			Handler:    _ControlService_AddPermissionlessDelegator_Handler,
+		},
+		{
+			MethodName: "AddPrimaryDelegator",
+			Handler:    _ControlService_AddPrimaryDelegator_Handler,
+		},
 		{
 			MethodName: "RemoveSubnetValidator",
 			Handler:    _ControlService_RemoveSubnetValidator_Handler,
@@ -1091,6 +1958,70 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnapshotNames",
 			Handler:    _ControlService_GetSnapshotNames_Handler,
 		},
+		{
+			MethodName: "GetSnapshotInfo",
+			Handler:    _ControlService_GetSnapshotInfo_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _ControlService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "ListNetworks",
+			Handler:    _ControlService_ListNetworks_Handler,
+		},
+		{
+			MethodName: "ApplyManifest",
+			Handler:    _ControlService_ApplyManifest_Handler,
+		},
+		{
+			MethodName: "PartitionNodes",
+			Handler:    _ControlService_PartitionNodes_Handler,
+		},
+		{
+			MethodName: "HealPartitions",
+			Handler:    _ControlService_HealPartitions_Handler,
+		},
+		{
+			MethodName: "SetLinkLatency",
+			Handler:    _ControlService_SetLinkLatency_Handler,
+		},
+		{
+			MethodName: "SetLinkLoss",
+			Handler:    _ControlService_SetLinkLoss_Handler,
+		},
+		{
+			MethodName: "StartChaos",
+			Handler:    _ControlService_StartChaos_Handler,
+		},
+		{
+			MethodName: "StopChaos",
+			Handler:    _ControlService_StopChaos_Handler,
+		},
+		{
+			MethodName: "GetChaosTimeline",
+			Handler:    _ControlService_GetChaosTimeline_Handler,
+		},
+		{
+			MethodName: "GetNodeLogs",
+			Handler:    _ControlService_GetNodeLogs_Handler,
+		},
+		{
+			MethodName: "Prune",
+			Handler:    _ControlService_Prune_Handler,
+		},
+		{
+			MethodName: "MigrateSnapshot",
+			Handler:    _ControlService_MigrateSnapshot_Handler,
+		},
+		{
+			MethodName: "Fund",
+			Handler:    _ControlService_Fund_Handler,
+		},
+		{
+			MethodName: "CrossChainTransfer",
+			Handler:    _ControlService_CrossChainTransfer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1098,6 +2029,26 @@ var ControlService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ControlService_StreamStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _ControlService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailLogs",
+			Handler:       _ControlService_TailLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _ControlService_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSnapshot",
+			Handler:       _ControlService_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "rpcpb/rpc.proto",
 }
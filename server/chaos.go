@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/chaos"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+var _ chaos.Target = (*chaosTarget)(nil)
+
+// chaosTarget executes the chaos disruptions over a network, taking
+// the network state lock for each one, as the RPC handlers do.
+type chaosTarget struct {
+	ns *networkState
+	// Network the chaos was started on. Actions fail once it is stopped,
+	// even if a new network is started with the same name.
+	network *localNetwork
+}
+
+func (t *chaosTarget) PauseNode(ctx context.Context, nodeName string) error {
+	return t.run(rpcpb.EventType_EVENT_TYPE_NODE_PAUSED, nodeName, func(nw network.Network) error {
+		return nw.PauseNode(ctx, nodeName)
+	})
+}
+
+func (t *chaosTarget) ResumeNode(ctx context.Context, nodeName string) error {
+	return t.run(rpcpb.EventType_EVENT_TYPE_NODE_RESUMED, nodeName, func(nw network.Network) error {
+		return nw.ResumeNode(ctx, nodeName)
+	})
+}
+
+func (t *chaosTarget) RestartNode(ctx context.Context, nodeName string) error {
+	return t.run(rpcpb.EventType_EVENT_TYPE_NODE_RESTARTED, nodeName, func(nw network.Network) error {
+		return nw.RestartNode(ctx, nodeName, "", "", "", nil, nil, nil)
+	})
+}
+
+func (t *chaosTarget) RemoveNode(ctx context.Context, nodeName string) error {
+	return t.run(rpcpb.EventType_EVENT_TYPE_NODE_REMOVED, nodeName, func(nw network.Network) error {
+		return nw.RemoveNode(ctx, nodeName)
+	})
+}
+
+// Executes [op] over the node [nodeName], and publishes its event.
+func (t *chaosTarget) run(eventType rpcpb.EventType, nodeName string, op func(network.Network) error) error {
+	t.ns.mu.Lock()
+	defer t.ns.mu.Unlock()
+
+	if t.ns.network == nil || t.ns.network != t.network {
+		return ErrNotBootstrapped
+	}
+	if err := op(t.ns.network.nw); err != nil {
+		return err
+	}
+	t.ns.network.publishNodeEvent(&rpcpb.Event{Type: eventType, NodeName: nodeName})
+	if err := t.ns.network.UpdateNodeInfo(); err != nil {
+		return err
+	}
+	t.ns.clusterInfo.NodeNames = maps.Keys(t.ns.network.nodeInfos)
+	sort.Strings(t.ns.clusterInfo.NodeNames)
+	t.ns.clusterInfo.NodeInfos = t.ns.network.nodeInfos
+	return nil
+}
+
+func (s *server) StartChaos(_ context.Context, req *rpcpb.StartChaosRequest) (*rpcpb.StartChaosResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	s.log.Debug("StartChaos", zap.Any("policy", req.Policy))
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+	if ns.chaos != nil && ns.chaos.Running() {
+		return nil, ErrChaosRunning
+	}
+
+	scheduler, err := chaos.New(
+		s.log,
+		getChaosPolicy(req.GetPolicy()),
+		&chaosTarget{ns: ns, network: ns.network},
+		ns.clusterInfo.NodeNames,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ns.chaos = scheduler
+	ns.chaos.Start()
+
+	return &rpcpb.StartChaosResponse{Policy: getRPCChaosPolicy(ns.chaos.Policy())}, nil
+}
+
+func (s *server) StopChaos(_ context.Context, req *rpcpb.StopChaosRequest) (*rpcpb.StopChaosResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	scheduler := ns.chaos
+	ns.mu.Unlock()
+
+	s.log.Debug("StopChaos")
+
+	if scheduler == nil {
+		return nil, ErrChaosNotStarted
+	}
+	// [ns.mu] must not be held, as the scheduler needs it to resume the paused nodes
+	scheduler.Stop()
+
+	return &rpcpb.StopChaosResponse{Timeline: getRPCChaosTimeline(scheduler.Timeline())}, nil
+}
+
+func (s *server) GetChaosTimeline(_ context.Context, req *rpcpb.GetChaosTimelineRequest) (*rpcpb.GetChaosTimelineResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+
+	s.log.Debug("GetChaosTimeline")
+
+	if ns.chaos == nil {
+		return nil, ErrChaosNotStarted
+	}
+
+	return &rpcpb.GetChaosTimelineResponse{
+		Running:  ns.chaos.Running(),
+		Policy:   getRPCChaosPolicy(ns.chaos.Policy()),
+		Timeline: getRPCChaosTimeline(ns.chaos.Timeline()),
+	}, nil
+}
+
+func getChaosPolicy(policy *rpcpb.ChaosPolicy) chaos.Policy {
+	disruptions := []chaos.Action{}
+	for _, disruption := range policy.GetDisruptions() {
+		disruptions = append(disruptions, chaos.Action(disruption))
+	}
+	return chaos.Policy{
+		Nodes:        policy.GetNodeNames(),
+		MaxDown:      int(policy.GetMaxDown()),
+		Distribution: chaos.Distribution(policy.GetIntervalDistribution()),
+		MinInterval:  time.Duration(policy.GetMinIntervalMs()) * time.Millisecond,
+		MaxInterval:  time.Duration(policy.GetMaxIntervalMs()) * time.Millisecond,
+		Disruptions:  disruptions,
+		MinDowntime:  time.Duration(policy.GetMinDowntimeMs()) * time.Millisecond,
+		MaxDowntime:  time.Duration(policy.GetMaxDowntimeMs()) * time.Millisecond,
+		Seed:         policy.GetSeed(),
+		Duration:     time.Duration(policy.GetDurationMs()) * time.Millisecond,
+	}
+}
+
+func getRPCChaosPolicy(policy chaos.Policy) *rpcpb.ChaosPolicy {
+	disruptions := []string{}
+	for _, disruption := range policy.Disruptions {
+		disruptions = append(disruptions, string(disruption))
+	}
+	return &rpcpb.ChaosPolicy{
+		NodeNames:            policy.Nodes,
+		MaxDown:              uint32(policy.MaxDown),
+		IntervalDistribution: string(policy.Distribution),
+		MinIntervalMs:        uint64(policy.MinInterval.Milliseconds()),
+		MaxIntervalMs:        uint64(policy.MaxInterval.Milliseconds()),
+		Disruptions:          disruptions,
+		MinDowntimeMs:        uint64(policy.MinDowntime.Milliseconds()),
+		MaxDowntimeMs:        uint64(policy.MaxDowntime.Milliseconds()),
+		Seed:                 policy.Seed,
+		DurationMs:           uint64(policy.Duration.Milliseconds()),
+	}
+}
+
+func getRPCChaosTimeline(timeline []chaos.Event) []*rpcpb.ChaosEvent {
+	events := make([]*rpcpb.ChaosEvent, 0, len(timeline))
+	for _, event := range timeline {
+		rpcEvent := &rpcpb.ChaosEvent{
+			Timestamp: event.Time.UnixMilli(),
+			Action:    string(event.Action),
+			NodeName:  event.NodeName,
+		}
+		if event.Err != nil {
+			rpcEvent.Error = event.Err.Error()
+		}
+		events = append(events, rpcEvent)
+	}
+	return events
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"go.uber.org/zap"
+)
+
+func (s *server) CrossChainTransfer(
+	_ context.Context,
+	req *rpcpb.CrossChainTransferRequest,
+) (*rpcpb.CrossChainTransferResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	s.log.Info("CrossChainTransfer",
+		zap.String("source-chain", req.GetSourceChain()),
+		zap.String("destination-chain", req.GetDestinationChain()),
+		zap.String("asset-id", req.GetAssetId()),
+		zap.Uint64("amount", req.GetAmount()),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	exportTxID, importTxID, err := ns.network.CrossChainTransfer(ctx, network.CrossChainTransferSpec{
+		SourceChain:      req.GetSourceChain(),
+		DestinationChain: req.GetDestinationChain(),
+		AssetID:          req.GetAssetId(),
+		Amount:           req.GetAmount(),
+		PrivateKey:       req.GetPrivateKey(),
+	})
+	if err != nil {
+		s.log.Error("failed to transfer between chains", zap.Error(err))
+		return nil, err
+	}
+
+	s.log.Info("successfully transferred between chains",
+		zap.Stringer("export-tx-id", exportTxID),
+		zap.Stringer("import-tx-id", importTxID),
+	)
+	return &rpcpb.CrossChainTransferResponse{
+		ExportTxId: exportTxID.String(),
+		ImportTxId: importTxID.String(),
+	}, nil
+}
+
+// Moves the funds of [transferSpec] between chains.
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) CrossChainTransfer(
+	ctx context.Context,
+	transferSpec network.CrossChainTransferSpec,
+) (ids.ID, ids.ID, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func(ctx context.Context) {
+		select {
+		case <-lc.stopCh:
+			// The network is stopped; return from method calls below.
+			cancel()
+		case <-ctx.Done():
+			// This method is done. Don't leak [ctx].
+		}
+	}(ctx)
+
+	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+		return ids.Empty, ids.Empty, err
+	}
+
+	return lc.nw.CrossChainTransfer(ctx, transferSpec)
+}
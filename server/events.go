@@ -0,0 +1,292 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// Number of past events kept to be resent to resuming clients
+	eventHistorySize = 1024
+	// Number of events buffered for a stream before it is closed
+	eventSubscriberBufferSize = 256
+
+	nodeHealthCheckFreq          = 3 * time.Second
+	relaunchedNodeHealthyTimeout = 5 * time.Minute
+)
+
+var ErrEventStreamOverflow = errors.New("event stream fell behind, resume it from the last received sequence number")
+
+// eventLog numbers the events of all the networks of the server, keeps the
+// last ones, and pushes the new ones to the subscribed streams.
+type eventLog struct {
+	lock sync.Mutex
+	// sequence number of the last event
+	sequence uint64
+	// last [eventHistorySize] events, in order
+	history     []*rpcpb.Event
+	subscribers map[*eventSubscriber]struct{}
+}
+
+type eventSubscriber struct {
+	networkName string
+	// Closed if the subscriber does not keep up with the events
+	ch chan *rpcpb.Event
+}
+
+func newEventLog() *eventLog {
+	return &eventLog{
+		subscribers: map[*eventSubscriber]struct{}{},
+	}
+}
+
+// Numbers [event], records it, and pushes it to the subscribers of its network.
+func (l *eventLog) publish(event *rpcpb.Event) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.sequence++
+	event.Sequence = l.sequence
+	event.Timestamp = time.Now().UnixMilli()
+	l.history = append(l.history, event)
+	if len(l.history) > eventHistorySize {
+		l.history = l.history[len(l.history)-eventHistorySize:]
+	}
+	for sub := range l.subscribers {
+		if sub.networkName != event.NetworkName {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			// dropping events would break the sequence, so the stream is closed
+			delete(l.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// Subscribes to the new events of [networkName]. If [afterSequence] is given,
+// also returns the kept events of [networkName] with a greater sequence number,
+// and whether older ones with a greater sequence number are no longer kept.
+// [unsubscribe] must be called when done.
+func (l *eventLog) subscribe(networkName string, afterSequence *uint64) (
+	backlog []*rpcpb.Event,
+	truncated bool,
+	sub *eventSubscriber,
+	unsubscribe func(),
+) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if afterSequence != nil {
+		if len(l.history) > 0 && l.history[0].Sequence > *afterSequence+1 {
+			truncated = true
+		}
+		for _, event := range l.history {
+			if event.Sequence > *afterSequence && event.NetworkName == networkName {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+	sub = &eventSubscriber{
+		networkName: networkName,
+		ch:          make(chan *rpcpb.Event, eventSubscriberBufferSize),
+	}
+	l.subscribers[sub] = struct{}{}
+	return backlog, truncated, sub, func() {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		if _, ok := l.subscribers[sub]; ok {
+			delete(l.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+func (s *server) StreamEvents(req *rpcpb.StreamEventsRequest, stream rpcpb.ControlService_StreamEventsServer) error {
+	networkName := getNetworkName(req.GetNetworkName())
+	s.log.Debug("StreamEvents", zap.String("network-name", networkName))
+
+	backlog, truncated, sub, unsubscribe := s.events.subscribe(networkName, req.AfterSequence)
+	defer unsubscribe()
+
+	send := func(event *rpcpb.Event) error {
+		err := stream.Send(&rpcpb.StreamEventsResponse{Event: event, HistoryTruncated: truncated})
+		truncated = false
+		if err != nil && isClientCanceled(stream.Context().Err(), err) {
+			s.log.Debug("client stream canceled", zap.Error(err))
+		}
+		return err
+	}
+
+	for _, event := range backlog {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-s.rootCtx.Done():
+			return nil
+		case <-stream.Context().Done():
+			err := stream.Context().Err()
+			if errors.Is(err, context.Canceled) {
+				return ErrStatusCanceled
+			}
+			return err
+		case event, ok := <-sub.ch:
+			if !ok {
+				return ErrEventStreamOverflow
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Publishes an event of the network.
+func (lc *localNetwork) publishEvent(event *rpcpb.Event) {
+	if lc.options.events == nil {
+		return
+	}
+	event.NetworkName = lc.options.networkName
+	lc.options.events.publish(event)
+}
+
+// Publishes an event of a node. Nodes that go down are reported
+// healthy again once they are.
+func (lc *localNetwork) publishNodeEvent(event *rpcpb.Event) {
+	if event.Type != rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY {
+		lc.healthyNodesLock.Lock()
+		lc.healthyNodes.Remove(event.NodeName)
+		lc.healthyNodesLock.Unlock()
+	}
+	switch event.Type {
+	case rpcpb.EventType_EVENT_TYPE_NODE_RESTARTED:
+		lc.countNodeRestart(restartReasonRequest)
+	case rpcpb.EventType_EVENT_TYPE_NODE_RELAUNCHED:
+		lc.countNodeRestart(restartReasonRelaunch)
+	case rpcpb.EventType_EVENT_TYPE_NODE_CRASHED:
+		lc.countNodeCrash()
+	}
+	lc.publishEvent(event)
+}
+
+// Publishes the started event of all the nodes of the network.
+// Assumes [lc.lock] is held.
+func (lc *localNetwork) publishStartedNodes() {
+	nodeNames := maps.Keys(lc.nodeInfos)
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		if !lc.nodeInfos[nodeName].Paused {
+			lc.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: nodeName})
+		}
+	}
+}
+
+// Publishes the created event of the subnets not in [prevSubnetIDs].
+// Assumes [lc.lock] is held.
+func (lc *localNetwork) publishNewSubnets(prevSubnetIDs []string) {
+	prev := set.Of(prevSubnetIDs...)
+	subnetIDs := maps.Keys(lc.subnets)
+	sort.Strings(subnetIDs)
+	for _, subnetID := range subnetIDs {
+		if !prev.Contains(subnetID) {
+			lc.publishEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_SUBNET_CREATED, SubnetId: subnetID})
+		}
+	}
+}
+
+// Publishes the healthy event of the nodes not reported healthy since they
+// were started.
+// Assumes the network is healthy, and [lc.lock] is held.
+func (lc *localNetwork) publishHealthyNodes() {
+	lc.healthyNodesLock.Lock()
+	nodeNames := []string{}
+	for nodeName, nodeInfo := range lc.nodeInfos {
+		if !nodeInfo.Paused && !lc.healthyNodes.Contains(nodeName) {
+			lc.healthyNodes.Add(nodeName)
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	lc.healthyNodesLock.Unlock()
+
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		lc.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY, NodeName: nodeName})
+	}
+}
+
+// Forwards the node events of [nw] until the network is stopped.
+func (lc *localNetwork) forwardNodeEvents(nw network.Network) {
+	for {
+		select {
+		case <-lc.stopCh:
+			return
+		case event := <-nw.NodeEvents():
+			switch event.Type {
+			case network.NodeCrashed:
+				lc.publishNodeEvent(&rpcpb.Event{
+					Type:     rpcpb.EventType_EVENT_TYPE_NODE_CRASHED,
+					NodeName: event.NodeName,
+					ExitCode: int32(event.ExitCode),
+				})
+			case network.NodeRelaunched:
+				lc.publishNodeEvent(&rpcpb.Event{
+					Type:     rpcpb.EventType_EVENT_TYPE_NODE_RELAUNCHED,
+					NodeName: event.NodeName,
+				})
+				go lc.awaitRelaunchedNodeHealthy(nw, event.NodeName)
+			}
+		}
+	}
+}
+
+// Publishes the healthy event of a relaunched node once it is healthy,
+// as the network health is not checked after a relaunch.
+func (lc *localNetwork) awaitRelaunchedNodeHealthy(nw network.Network, nodeName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), relaunchedNodeHealthyTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-lc.stopCh:
+			return
+		case <-ctx.Done():
+			lc.log.Debug("relaunched node didn't become healthy", zap.String("node", nodeName))
+			return
+		case <-time.After(nodeHealthCheckFreq):
+		}
+		node, err := nw.GetNode(nodeName)
+		if err != nil {
+			return
+		}
+		health, err := node.GetAPIClient().HealthAPI().Health(ctx, nil)
+		if err != nil || !health.Healthy {
+			continue
+		}
+		lc.healthyNodesLock.Lock()
+		reported := lc.healthyNodes.Contains(nodeName)
+		lc.healthyNodes.Add(nodeName)
+		lc.healthyNodesLock.Unlock()
+		if !reported {
+			lc.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY, NodeName: nodeName})
+		}
+		return
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/stretchr/testify/require"
+)
+
+// Returns the sequence numbers of [events].
+func getSequences(events []*rpcpb.Event) []uint64 {
+	sequences := make([]uint64, 0, len(events))
+	for _, event := range events {
+		sequences = append(sequences, event.Sequence)
+	}
+	return sequences
+}
+
+func TestEventLogPublishOrder(t *testing.T) {
+	require := require.New(t)
+
+	l := newEventLog()
+	_, _, sub, unsubscribe := l.subscribe("net1", nil)
+	defer unsubscribe()
+
+	l.publish(&rpcpb.Event{NetworkName: "net1", Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED})
+	// events of other networks are numbered, but not pushed
+	l.publish(&rpcpb.Event{NetworkName: "net2", Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED})
+	l.publish(&rpcpb.Event{NetworkName: "net1", Type: rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY})
+
+	first := <-sub.ch
+	require.Equal(uint64(1), first.Sequence)
+	require.Equal(rpcpb.EventType_EVENT_TYPE_NODE_STARTED, first.Type)
+	require.NotZero(first.Timestamp)
+	second := <-sub.ch
+	require.Equal(uint64(3), second.Sequence)
+	require.Equal(rpcpb.EventType_EVENT_TYPE_NODE_HEALTHY, second.Type)
+	require.Empty(sub.ch)
+}
+
+func TestEventLogResume(t *testing.T) {
+	require := require.New(t)
+
+	l := newEventLog()
+	for i := 0; i < 5; i++ {
+		l.publish(&rpcpb.Event{NetworkName: "net1"})
+	}
+	l.publish(&rpcpb.Event{NetworkName: "net2"})
+
+	afterSequence := uint64(3)
+	backlog, truncated, sub, unsubscribe := l.subscribe("net1", &afterSequence)
+	defer unsubscribe()
+	require.False(truncated)
+	require.Equal([]uint64{4, 5}, getSequences(backlog))
+
+	// new events follow the backlog
+	l.publish(&rpcpb.Event{NetworkName: "net1"})
+	require.Equal(uint64(7), (<-sub.ch).Sequence)
+
+	// without a resume point, there is no backlog
+	backlog, truncated, _, unsubscribe2 := l.subscribe("net1", nil)
+	defer unsubscribe2()
+	require.False(truncated)
+	require.Empty(backlog)
+}
+
+func TestEventLogResumeTruncated(t *testing.T) {
+	require := require.New(t)
+
+	l := newEventLog()
+	for i := 0; i < eventHistorySize+10; i++ {
+		l.publish(&rpcpb.Event{NetworkName: "net1"})
+	}
+	require.Len(l.history, eventHistorySize)
+	require.Equal(uint64(11), l.history[0].Sequence)
+
+	// events 6 to 10 are no longer kept
+	afterSequence := uint64(5)
+	backlog, truncated, _, unsubscribe := l.subscribe("net1", &afterSequence)
+	defer unsubscribe()
+	require.True(truncated)
+	require.Len(backlog, eventHistorySize)
+	require.Equal(uint64(11), backlog[0].Sequence)
+
+	// the first kept event directly follows the resume point
+	afterSequence = 10
+	backlog, truncated, _, unsubscribe2 := l.subscribe("net1", &afterSequence)
+	defer unsubscribe2()
+	require.False(truncated)
+	require.Len(backlog, eventHistorySize)
+}
+
+func TestEventLogOverflow(t *testing.T) {
+	require := require.New(t)
+
+	l := newEventLog()
+	_, _, sub, unsubscribe := l.subscribe("net1", nil)
+	for i := 0; i < eventSubscriberBufferSize+1; i++ {
+		l.publish(&rpcpb.Event{NetworkName: "net1"})
+	}
+	require.Empty(l.subscribers)
+
+	// the buffered events are still delivered, then the channel is closed
+	received := 0
+	for range sub.ch {
+		received++
+	}
+	require.Equal(eventSubscriberBufferSize, received)
+
+	// unsubscribing a closed subscriber is a no-op
+	unsubscribe()
+}
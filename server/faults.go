@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) PartitionNodes(ctx context.Context, req *rpcpb.PartitionNodesRequest) (*rpcpb.PartitionNodesResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	groups := make([][]string, 0, len(req.Groups))
+	for _, group := range req.Groups {
+		groups = append(groups, group.GetNodeNames())
+	}
+
+	s.log.Debug("PartitionNodes", zap.Any("groups", groups))
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := ns.network.nw.PartitionNodes(ctx, groups); err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.PartitionNodesResponse{ClusterInfo: ns.clusterInfo}, nil
+}
+
+func (s *server) HealPartitions(ctx context.Context, req *rpcpb.HealPartitionsRequest) (*rpcpb.HealPartitionsResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	s.log.Debug("HealPartitions")
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := ns.network.nw.HealPartitions(ctx); err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.HealPartitionsResponse{ClusterInfo: ns.clusterInfo}, nil
+}
+
+func (s *server) SetLinkLatency(ctx context.Context, req *rpcpb.SetLinkLatencyRequest) (*rpcpb.SetLinkLatencyResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	s.log.Debug("SetLinkLatency",
+		zap.String("from", req.From),
+		zap.String("to", req.To),
+		zap.Uint64("delay-ms", req.DelayMs),
+		zap.Uint64("jitter-ms", req.JitterMs),
+	)
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := ns.network.nw.SetLinkLatency(
+		ctx,
+		req.From,
+		req.To,
+		time.Duration(req.DelayMs)*time.Millisecond,
+		time.Duration(req.JitterMs)*time.Millisecond,
+	); err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.SetLinkLatencyResponse{ClusterInfo: ns.clusterInfo}, nil
+}
+
+func (s *server) SetLinkLoss(ctx context.Context, req *rpcpb.SetLinkLossRequest) (*rpcpb.SetLinkLossResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	s.log.Debug("SetLinkLoss",
+		zap.String("from", req.From),
+		zap.String("to", req.To),
+		zap.Float64("loss", req.Loss),
+	)
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if err := ns.network.nw.SetLinkLoss(ctx, req.From, req.To, req.Loss); err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.SetLinkLossResponse{ClusterInfo: ns.clusterInfo}, nil
+}
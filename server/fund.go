@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) Fund(_ context.Context, req *rpcpb.FundRequest) (*rpcpb.FundResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	s.log.Info("Fund",
+		zap.String("address", req.GetAddress()),
+		zap.String("asset-id", req.GetAssetId()),
+		zap.Uint64("amount", req.GetAmount()),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	txID, err := ns.network.Fund(ctx, network.FundSpec{
+		Address: req.GetAddress(),
+		AssetID: req.GetAssetId(),
+		Amount:  req.GetAmount(),
+	})
+	if err != nil {
+		s.log.Error("failed to fund address", zap.String("address", req.GetAddress()), zap.Error(err))
+		return nil, err
+	}
+
+	s.log.Info("successfully funded address", zap.String("address", req.GetAddress()), zap.String("tx-id", txID))
+	return &rpcpb.FundResponse{TxId: txID}, nil
+}
+
+// Sends the funds of [fundSpec] from the EWOQ key.
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) Fund(ctx context.Context, fundSpec network.FundSpec) (string, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func(ctx context.Context) {
+		select {
+		case <-lc.stopCh:
+			// The network is stopped; return from method calls below.
+			cancel()
+		case <-ctx.Done():
+			// This method is done. Don't leak [ctx].
+		}
+	}(ctx)
+
+	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+		return "", err
+	}
+
+	return lc.nw.Fund(ctx, fundSpec)
+}
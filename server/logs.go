@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/logs"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	defaultLogsLimit = 100
+	// Number of entries buffered between the followed logs and the stream
+	tailLogsBufferSize = 256
+)
+
+// log file to read for a node
+type nodeLog struct {
+	nodeName string
+	logsDir  string
+	logName  string
+}
+
+func (s *server) GetNodeLogs(_ context.Context, req *rpcpb.GetNodeLogsRequest) (*rpcpb.GetNodeLogsResponse, error) {
+	s.log.Debug("GetNodeLogs", zap.Strings("node-names", req.NodeNames))
+
+	filter, err := getLogFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	nodeLogs, err := s.getNodeLogFiles(req.GetNetworkName(), req.NodeNames, req.Filter.GetChain())
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*rpcpb.LogEntry{}
+	for _, nodeLog := range nodeLogs {
+		logEntries, err := logs.Read(nodeLog.logsDir, nodeLog.logName, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range logEntries {
+			entries = append(entries, getRPCLogEntry(nodeLog.nodeName, entry))
+		}
+	}
+	// entries of each node are in order already
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp < entries[j].Timestamp
+	})
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultLogsLimit
+	}
+	offset := int(req.Offset)
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	end := offset + limit
+	nextOffset := uint32(end)
+	if end >= len(entries) {
+		end = len(entries)
+		nextOffset = 0
+	}
+	return &rpcpb.GetNodeLogsResponse{
+		Entries:    entries[offset:end],
+		NextOffset: nextOffset,
+	}, nil
+}
+
+func (s *server) TailLogs(req *rpcpb.TailLogsRequest, stream rpcpb.ControlService_TailLogsServer) error {
+	s.log.Debug("TailLogs", zap.Strings("node-names", req.NodeNames))
+
+	filter, err := getLogFilter(req.Filter)
+	if err != nil {
+		return err
+	}
+	nodeLogs, err := s.getNodeLogFiles(req.GetNetworkName(), req.NodeNames, req.Filter.GetChain())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.rootCtx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	entryCh := make(chan *rpcpb.LogEntry, tailLogsBufferSize)
+	errCh := make(chan error, len(nodeLogs))
+	wg := sync.WaitGroup{}
+	for _, nodeLog := range nodeLogs {
+		nodeLog := nodeLog
+		nodeEntryCh := make(chan logs.Entry)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer close(nodeEntryCh)
+			if err := logs.Follow(ctx, nodeLog.logsDir, nodeLog.logName, int(req.Lines), filter, nodeEntryCh); err != nil && ctx.Err() == nil {
+				errCh <- fmt.Errorf("couldn't follow log of node %q: %w", nodeLog.nodeName, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for entry := range nodeEntryCh {
+				select {
+				case <-ctx.Done():
+				case entryCh <- getRPCLogEntry(nodeLog.nodeName, entry):
+				}
+			}
+		}()
+	}
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			err := stream.Context().Err()
+			if errors.Is(err, context.Canceled) {
+				return ErrStatusCanceled
+			}
+			return err
+		case err := <-errCh:
+			return err
+		case entry := <-entryCh:
+			if err := stream.Send(&rpcpb.TailLogsResponse{Entry: entry}); err != nil {
+				if isClientCanceled(stream.Context().Err(), err) {
+					s.log.Debug("client stream canceled", zap.Error(err))
+				}
+				return err
+			}
+		}
+	}
+}
+
+// Returns the log file of [chain] of each of [nodeNames], or of all
+// the nodes if empty.
+func (s *server) getNodeLogFiles(networkName string, nodeNames []string, chain string) ([]nodeLog, error) {
+	ns := s.getNetworkState(networkName)
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	if len(nodeNames) == 0 {
+		nodeNames = maps.Keys(ns.network.nodeInfos)
+		sort.Strings(nodeNames)
+	}
+	logNames := ns.network.getLogNames(chain)
+	nodeLogs := []nodeLog{}
+	for _, nodeName := range nodeNames {
+		nodeInfo, ok := ns.network.nodeInfos[nodeName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrNodeNotFound, nodeName)
+		}
+		logName, err := logs.FindLogName(nodeInfo.LogDir, logNames...)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't find log of node %q: %w", nodeName, err)
+		}
+		nodeLogs = append(nodeLogs, nodeLog{
+			nodeName: nodeName,
+			logsDir:  nodeInfo.LogDir,
+			logName:  logName,
+		})
+	}
+	return nodeLogs, nil
+}
+
+// Returns the names the log of [chain] may have. Chains log to a file
+// named after their primary alias, which is the blockchain ID of the
+// custom chains.
+// Assumes [ns.mu] is held.
+func (lc *localNetwork) getLogNames(chain string) []string {
+	if chain == "" {
+		return []string{logs.MainLogName}
+	}
+	logNames := []string{chain}
+	for blockchainID, chainInfo := range lc.customChainIDToInfo {
+		if chainInfo.info.GetChainName() == chain || chainInfo.info.GetChainId() == chain {
+			logNames = append(logNames, blockchainID.String())
+		}
+	}
+	return logNames
+}
+
+func getLogFilter(filter *rpcpb.LogFilter) (logs.Filter, error) {
+	minLevel := logging.Verbo
+	if filter.GetMinLevel() != "" {
+		var err error
+		minLevel, err = logging.ToLevel(filter.GetMinLevel())
+		if err != nil {
+			return logs.Filter{}, err
+		}
+	}
+	f := logs.Filter{
+		MinLevel: minLevel,
+		Contains: filter.GetContains(),
+	}
+	if filter.GetSince() != 0 {
+		f.Since = time.UnixMilli(filter.GetSince())
+	}
+	if filter.GetUntil() != 0 {
+		f.Until = time.UnixMilli(filter.GetUntil())
+	}
+	return f, nil
+}
+
+func getRPCLogEntry(nodeName string, entry logs.Entry) *rpcpb.LogEntry {
+	rpcEntry := &rpcpb.LogEntry{
+		NodeName: nodeName,
+		LogName:  entry.LogName,
+		Level:    entry.Level,
+		Text:     entry.Text,
+	}
+	if !entry.Time.IsZero() {
+		rpcEntry.Timestamp = entry.Time.UnixMilli()
+	}
+	return rpcEntry
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/manifest"
+	"github.com/DioneProtocol/odyssey-network-runner/network"
+	"github.com/DioneProtocol/odyssey-network-runner/network/node"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+)
+
+func (s *server) ApplyManifest(_ context.Context, req *rpcpb.ApplyManifestRequest) (*rpcpb.ApplyManifestResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	s.log.Debug("ApplyManifest", zap.Bool("dry-run", req.DryRun))
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	m, err := manifest.Parse(readFileOrString(req.Manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := manifest.Diff(m, ns.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range plan.Strings() {
+		s.log.Info("manifest plan", zap.String("action", action))
+	}
+
+	if !req.DryRun && len(plan.Actions) > 0 {
+		ns.clusterInfo.Healthy = false
+		ns.clusterInfo.CustomChainsHealthy = false
+
+		err := s.applyPlan(ns, plan)
+
+		s.updateClusterInfo(ns)
+
+		if err != nil {
+			s.log.Error("failed to apply manifest", zap.Error(err))
+			return nil, err
+		}
+		s.log.Info("manifest applied")
+	}
+
+	clusterInfo, err := deepCopy(ns.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ApplyManifestResponse{ClusterInfo: clusterInfo, Plan: plan.Strings()}, nil
+}
+
+// Executes [plan] over the network of [ns], batching the actions of the
+// same type into a single network call.
+// Assumes [ns.mu] is held.
+func (s *server) applyPlan(ns *networkState, plan *manifest.Plan) error {
+	// IDs of the subnets created by the plan, and asset IDs of the
+	// subnets transformed by the plan, by manifest subnet name
+	subnetIDs := map[string]string{}
+	assetIDs := map[string]string{}
+	getSubnetID := func(action manifest.Action) string {
+		if action.SubnetID != "" {
+			return action.SubnetID
+		}
+		return subnetIDs[action.Subnet.Name]
+	}
+
+	if actions := plan.ActionsOf(manifest.RemoveSubnetValidators); len(actions) > 0 {
+		validatorSpecs := []network.RemoveSubnetValidatorSpec{}
+		for _, action := range actions {
+			validatorSpecs = append(validatorSpecs, network.RemoveSubnetValidatorSpec{
+				SubnetID:  action.SubnetID,
+				NodeNames: action.NodeNames,
+			})
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+		err := ns.network.RemoveSubnetValidator(ctx, validatorSpecs)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	removeNodeActions := plan.ActionsOf(manifest.RemoveNode)
+	for _, action := range removeNodeActions {
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		err := ns.network.nw.RemoveNode(ctx, action.Node.Name)
+		cancel()
+		if err != nil {
+			return err
+		}
+		ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_REMOVED, NodeName: action.Node.Name})
+	}
+
+	addNodeActions := plan.ActionsOf(manifest.AddNode)
+	for _, action := range addNodeActions {
+		nodeConfig := node.Config{
+			Name:           action.Node.Name,
+			Flags:          maps.Clone(action.Node.Flags),
+			BinaryPath:     action.Node.ExecPath,
+			RedirectStdout: s.cfg.RedirectNodesOutput,
+			RedirectStderr: s.cfg.RedirectNodesOutput,
+		}
+		if nodeConfig.Flags == nil {
+			nodeConfig.Flags = map[string]interface{}{}
+		}
+		if _, err := ns.network.nw.AddNode(nodeConfig); err != nil {
+			return err
+		}
+		ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: action.Node.Name})
+	}
+
+	if len(removeNodeActions) > 0 || len(addNodeActions) > 0 {
+		if err := ns.network.UpdateNodeInfo(); err != nil {
+			return err
+		}
+	}
+
+	if actions := plan.ActionsOf(manifest.CreateSubnet); len(actions) > 0 {
+		subnetSpecs := []network.SubnetSpec{}
+		for _, action := range actions {
+			subnetSpecs = append(subnetSpecs, getNetworkSubnetSpec(&rpcpb.SubnetSpec{
+				Participants: action.Subnet.Participants,
+				SubnetConfig: action.Subnet.Config,
+			}))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+		createdSubnetIDs, err := ns.network.CreateSubnets(ctx, subnetSpecs)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for i, action := range actions {
+			subnetIDs[action.Subnet.Name] = createdSubnetIDs[i].String()
+		}
+	}
+
+	if actions := plan.ActionsOf(manifest.TransformSubnet); len(actions) > 0 {
+		elasticSubnetSpecs := []network.ElasticSubnetSpec{}
+		for _, action := range actions {
+			elastic := action.Subnet.Elastic
+			elasticSubnetSpecs = append(elasticSubnetSpecs, getNetworkElasticSubnetSpec(&rpcpb.ElasticSubnetSpec{
+				SubnetId:                  getSubnetID(action),
+				AssetName:                 elastic.AssetName,
+				AssetSymbol:               elastic.AssetSymbol,
+				InitialSupply:             elastic.InitialSupply,
+				MaxSupply:                 elastic.MaxSupply,
+				MinConsumptionRate:        elastic.MinConsumptionRate,
+				MaxConsumptionRate:        elastic.MaxConsumptionRate,
+				MinValidatorStake:         elastic.MinValidatorStake,
+				MaxValidatorStake:         elastic.MaxValidatorStake,
+				MinValidatorStakeDuration: elastic.MinValidatorStakeDuration,
+				MaxValidatorStakeDuration: elastic.MaxValidatorStakeDuration,
+				MinDelegatorStakeDuration: elastic.MinDelegatorStakeDuration,
+				MaxDelegatorStakeDuration: elastic.MaxDelegatorStakeDuration,
+				MinDelegationFee:          elastic.MinDelegationFee,
+				MinDelegatorStake:         elastic.MinDelegatorStake,
+				MaxValidatorWeightFactor:  elastic.MaxValidatorWeightFactor,
+				UptimeRequirement:         elastic.UptimeRequirement,
+			}))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+		_, transformedAssetIDs, err := ns.network.TransformSubnets(ctx, elasticSubnetSpecs)
+		cancel()
+		if err != nil {
+			return err
+		}
+		for i, action := range actions {
+			assetIDs[action.Subnet.Name] = transformedAssetIDs[i].String()
+		}
+	}
+
+	if actions := plan.ActionsOf(manifest.AddPermissionlessValidator); len(actions) > 0 {
+		validatorSpecs := []network.PermissionlessValidatorSpec{}
+		for _, action := range actions {
+			assetID, ok := assetIDs[action.Subnet.Name]
+			if !ok {
+				assetID = action.Subnet.Elastic.AssetID
+			}
+			validatorSpecs = append(validatorSpecs, network.PermissionlessValidatorSpec{
+				SubnetID:      getSubnetID(action),
+				AssetID:       assetID,
+				NodeName:      action.Validator.NodeName,
+				StakedAmount:  action.Validator.StakedAmount,
+				StakeDuration: time.Duration(action.Validator.StakeDuration) * time.Hour,
+			})
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+		err := ns.network.AddPermissionlessValidators(ctx, validatorSpecs)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	if actions := plan.ActionsOf(manifest.CreateBlockchain); len(actions) > 0 {
+		chainSpecs := []network.BlockchainSpec{}
+		for _, action := range actions {
+			subnetID := getSubnetID(action)
+			chainSpec, err := getNetworkBlockchainSpec(s.log, &rpcpb.BlockchainSpec{
+				VmName:             action.Blockchain.VMName,
+				Genesis:            action.Blockchain.Genesis,
+				SubnetId:           &subnetID,
+				ChainConfig:        action.Blockchain.ChainConfig,
+				NetworkUpgrade:     action.Blockchain.NetworkUpgrade,
+				BlockchainAlias:    action.Blockchain.BlockchainAlias,
+				PerNodeChainConfig: action.Blockchain.PerNodeChainConfig,
+			}, false, ns.network.pluginDir)
+			if err != nil {
+				return err
+			}
+			chainSpecs = append(chainSpecs, chainSpec)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+		_, err := ns.network.CreateChains(ctx, chainSpecs)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+
+	s.log.Info("waiting for network to become healthy after applying manifest")
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	return ns.network.AwaitHealthyAndUpdateNetworkInfo(ctx)
+}
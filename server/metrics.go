@@ -0,0 +1,323 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	metricsNamespace = "network_runner"
+	metricsPath      = "/metrics"
+	nodeMetricsPath  = "/ext/metrics"
+	// Max time to scrape the metrics of a node
+	nodeMetricsTimeout = 5 * time.Second
+
+	// Operations whose duration is measured
+	operationStart         = "start"
+	operationLoadSnapshot  = "load_snapshot"
+	operationCreateChains  = "create_chains"
+	operationCreateSubnets = "create_subnets"
+	operationAwaitHealthy  = "await_healthy"
+
+	restartReasonRequest  = "request"
+	restartReasonRelaunch = "relaunch"
+)
+
+// serverMetrics are the metrics of the runner itself, served along with
+// the ones of the nodes.
+type serverMetrics struct {
+	registry *prometheus.Registry
+
+	rpcDuration       *prometheus.HistogramVec
+	operationDuration *prometheus.HistogramVec
+	nodeRestarts      *prometheus.CounterVec
+	nodeCrashes       *prometheus.CounterVec
+	nodes             *prometheus.GaugeVec
+
+	// Serializes the updates of [nodes] done on each scrape
+	nodesLock sync.Mutex
+	// Names of the networks with series in [nodes]
+	nodesNetworks map[string]struct{}
+}
+
+func newServerMetrics() (*serverMetrics, error) {
+	m := &serverMetrics{
+		registry: prometheus.NewRegistry(),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Duration of the unary RPCs handled by the server",
+			Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 120, 300},
+		}, []string{"method", "code"}),
+		operationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of the successful network operations",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600},
+		}, []string{"network_name", "operation"}),
+		nodeRestarts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "node_restarts_total",
+			Help:      "Number of node restarts, requested or done by the restart policy",
+		}, []string{"network_name", "reason"}),
+		nodeCrashes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "node_crashes_total",
+			Help:      "Number of unexpected node process exits",
+		}, []string{"network_name"}),
+		nodes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "nodes",
+			Help:      "Number of nodes of the network",
+		}, []string{"network_name", "state"}),
+		nodesNetworks: map[string]struct{}{},
+	}
+	for _, c := range []prometheus.Collector{
+		m.rpcDuration,
+		m.operationDuration,
+		m.nodeRestarts,
+		m.nodeCrashes,
+		m.nodes,
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	} {
+		if err := m.registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Measures the duration of the unary RPCs.
+func (m *serverMetrics) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.rpcDuration.WithLabelValues(info.FullMethod, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// Records the duration of [operation], started at [start].
+func (lc *localNetwork) observeOperation(operation string, start time.Time) {
+	if lc.options.metrics == nil {
+		return
+	}
+	lc.options.metrics.operationDuration.
+		WithLabelValues(lc.options.networkName, operation).
+		Observe(time.Since(start).Seconds())
+}
+
+// Counts a restart of a node of the network.
+func (lc *localNetwork) countNodeRestart(reason string) {
+	if lc.options.metrics == nil {
+		return
+	}
+	lc.options.metrics.nodeRestarts.WithLabelValues(lc.options.networkName, reason).Inc()
+}
+
+// Counts a crash of a node of the network.
+func (lc *localNetwork) countNodeCrash() {
+	if lc.options.metrics == nil {
+		return
+	}
+	lc.options.metrics.nodeCrashes.WithLabelValues(lc.options.networkName).Inc()
+}
+
+// node whose metrics are scraped
+type metricsTarget struct {
+	networkName string
+	nodeName    string
+	nodeID      string
+	uri         string
+}
+
+// Serves the metrics of the runner, and the ones of all the non-paused
+// nodes labeled with their network, name and ID.
+func (s *server) serveMetrics(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.metrics.nodesLock.Lock()
+	targets := s.getMetricsTargets()
+	gatherers := prometheus.Gatherers{
+		s.metrics.registry,
+		&nodeMetricsGatherer{ctx: r.Context(), log: s.log, targets: targets},
+	}
+	// the node series of an inconsistent node are skipped
+	handler := promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+	handler.ServeHTTP(w, r)
+	s.metrics.nodesLock.Unlock()
+}
+
+// Returns the non-paused nodes of all the running networks, and updates
+// the node count metrics.
+// Networks busy with an operation are skipped, as it may take minutes, and
+// keep their last node counts. The node counts of a network are dropped
+// once it is stopped, or removed from [s.networks].
+// Assumes [s.metrics.nodesLock] is held.
+func (s *server) getMetricsTargets() []metricsTarget {
+	s.mu.RLock()
+	networks := maps.Values(s.networks)
+	s.mu.RUnlock()
+
+	// networks whose node counts are kept
+	counted := map[string]struct{}{}
+	targets := []metricsTarget{}
+	for _, ns := range networks {
+		if !ns.mu.TryRLock() {
+			s.log.Debug("skipping metrics of busy network", zap.String("network-name", ns.name))
+			counted[ns.name] = struct{}{}
+			continue
+		}
+		if ns.network != nil {
+			networkTargets, running, paused := getNetworkMetricsTargets(ns.name, ns.clusterInfo)
+			targets = append(targets, networkTargets...)
+			s.metrics.nodes.WithLabelValues(ns.name, "running").Set(float64(running))
+			s.metrics.nodes.WithLabelValues(ns.name, "paused").Set(float64(paused))
+			s.metrics.nodesNetworks[ns.name] = struct{}{}
+			counted[ns.name] = struct{}{}
+		}
+		ns.mu.RUnlock()
+	}
+	for networkName := range s.metrics.nodesNetworks {
+		if _, ok := counted[networkName]; ok {
+			continue
+		}
+		s.metrics.nodes.DeletePartialMatch(prometheus.Labels{"network_name": networkName})
+		delete(s.metrics.nodesNetworks, networkName)
+	}
+	return targets
+}
+
+// Returns the non-paused nodes of [clusterInfo], along with the number of
+// running and paused nodes.
+func getNetworkMetricsTargets(networkName string, clusterInfo *rpcpb.ClusterInfo) ([]metricsTarget, int, int) {
+	targets := []metricsTarget{}
+	running, paused := 0, 0
+	for _, nodeInfo := range clusterInfo.NodeInfos {
+		if nodeInfo.Paused {
+			paused++
+			continue
+		}
+		running++
+		targets = append(targets, metricsTarget{
+			networkName: networkName,
+			nodeName:    nodeInfo.Name,
+			nodeID:      nodeInfo.Id,
+			uri:         nodeInfo.Uri,
+		})
+	}
+	return targets, running, paused
+}
+
+// nodeMetricsGatherer scrapes the metrics of nodes.
+type nodeMetricsGatherer struct {
+	ctx     context.Context
+	log     logging.Logger
+	targets []metricsTarget
+}
+
+// Scrapes the nodes concurrently. Nodes that can't be scraped are skipped.
+func (g *nodeMetricsGatherer) Gather() ([]*dto.MetricFamily, error) {
+	familiesPerTarget := make([][]*dto.MetricFamily, len(g.targets))
+	wg := sync.WaitGroup{}
+	for i, target := range g.targets {
+		i, target := i, target
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			families, err := scrapeNode(g.ctx, target)
+			if err != nil {
+				g.log.Warn("couldn't scrape node metrics",
+					zap.String("network-name", target.networkName),
+					zap.String("node-name", target.nodeName),
+					zap.Error(err),
+				)
+				return
+			}
+			familiesPerTarget[i] = families
+		}()
+	}
+	wg.Wait()
+
+	families := []*dto.MetricFamily{}
+	for _, targetFamilies := range familiesPerTarget {
+		families = append(families, targetFamilies...)
+	}
+	return families, nil
+}
+
+// Returns the metrics of [target], labeled with its network, name and ID.
+func scrapeNode(ctx context.Context, target metricsTarget) ([]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(ctx, nodeMetricsTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.uri+nodeMetricsPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	parser := expfmt.TextParser{}
+	familiesByName, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	targetLabels := []*dto.LabelPair{
+		{Name: proto.String("network_name"), Value: proto.String(target.networkName)},
+		{Name: proto.String("node_id"), Value: proto.String(target.nodeID)},
+		{Name: proto.String("node_name"), Value: proto.String(target.nodeName)},
+	}
+	families := make([]*dto.MetricFamily, 0, len(familiesByName))
+	for _, family := range familiesByName {
+		for _, metric := range family.Metric {
+			metric.Label = relabel(metric.Label, targetLabels)
+		}
+		families = append(families, family)
+	}
+	return families, nil
+}
+
+// Returns [labels] with [extra], which replace the labels of the same name,
+// sorted by name.
+func relabel(labels []*dto.LabelPair, extra []*dto.LabelPair) []*dto.LabelPair {
+	byName := map[string]*dto.LabelPair{}
+	for _, label := range labels {
+		byName[label.GetName()] = label
+	}
+	for _, label := range extra {
+		byName[label.GetName()] = label
+	}
+	relabeled := maps.Values(byName)
+	sort.Slice(relabeled, func(i, j int) bool {
+		return relabeled[i].GetName() < relabeled[j].GetName()
+	})
+	return relabeled
+}
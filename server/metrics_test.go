@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRelabel(t *testing.T) {
+	require := require.New(t)
+
+	labels := []*dto.LabelPair{
+		{Name: proto.String("zone"), Value: proto.String("a")},
+		{Name: proto.String("node_id"), Value: proto.String("reported")},
+		{Name: proto.String("chain"), Value: proto.String("O")},
+	}
+	extra := []*dto.LabelPair{
+		{Name: proto.String("network_name"), Value: proto.String("net1")},
+		{Name: proto.String("node_id"), Value: proto.String("NodeID-1")},
+	}
+	relabeled := relabel(labels, extra)
+
+	names := []string{}
+	values := []string{}
+	for _, label := range relabeled {
+		names = append(names, label.GetName())
+		values = append(values, label.GetValue())
+	}
+	require.Equal([]string{"chain", "network_name", "node_id", "zone"}, names)
+	require.Equal([]string{"O", "net1", "NodeID-1", "a"}, values)
+}
+
+func TestGetNetworkMetricsTargets(t *testing.T) {
+	require := require.New(t)
+
+	targets, running, paused := getNetworkMetricsTargets("net1", &rpcpb.ClusterInfo{
+		NodeInfos: map[string]*rpcpb.NodeInfo{
+			"node1": {Name: "node1", Id: "NodeID-1", Uri: "http://127.0.0.1:9650"},
+			"node2": {Name: "node2", Id: "NodeID-2", Uri: "http://127.0.0.1:9652", Paused: true},
+			"node3": {Name: "node3", Id: "NodeID-3", Uri: "http://127.0.0.1:9654"},
+		},
+	})
+	require.Equal(2, running)
+	require.Equal(1, paused)
+	require.ElementsMatch([]metricsTarget{
+		{networkName: "net1", nodeName: "node1", nodeID: "NodeID-1", uri: "http://127.0.0.1:9650"},
+		{networkName: "net1", nodeName: "node3", nodeID: "NodeID-3", uri: "http://127.0.0.1:9654"},
+	}, targets)
+}
+
+// Returns a running network state named [networkName] with [nodeInfos].
+// Its network has no nodes to stop.
+func newTestNetworkState(networkName string, nodeInfos map[string]*rpcpb.NodeInfo) *networkState {
+	ns := newNetworkState(networkName)
+	ns.clusterInfo = &rpcpb.ClusterInfo{NetworkName: networkName, NodeInfos: nodeInfos}
+	ns.network = &localNetwork{
+		log:     logging.NoLog{},
+		options: localNetworkOptions{networkName: networkName},
+		stopCh:  make(chan struct{}),
+	}
+	return ns
+}
+
+func TestGetMetricsTargets(t *testing.T) {
+	require := require.New(t)
+
+	metrics, err := newServerMetrics()
+	require.NoError(err)
+	net1 := newTestNetworkState("net1", map[string]*rpcpb.NodeInfo{
+		"node1": {Name: "node1", Id: "NodeID-1", Uri: "http://127.0.0.1:9650"},
+		"node2": {Name: "node2", Id: "NodeID-2", Uri: "http://127.0.0.1:9652", Paused: true},
+	})
+	net2 := newTestNetworkState("net2", map[string]*rpcpb.NodeInfo{
+		"node1": {Name: "node1", Id: "NodeID-3", Uri: "http://127.0.0.1:9660"},
+	})
+	s := &server{
+		mu:       new(sync.RWMutex),
+		log:      logging.NoLog{},
+		networks: map[string]*networkState{"net1": net1, "net2": net2},
+		metrics:  metrics,
+	}
+
+	targets := s.getMetricsTargets()
+	require.Len(targets, 2)
+	require.Equal(float64(1), testutil.ToFloat64(metrics.nodes.WithLabelValues("net1", "running")))
+	require.Equal(float64(1), testutil.ToFloat64(metrics.nodes.WithLabelValues("net1", "paused")))
+	require.Equal(float64(1), testutil.ToFloat64(metrics.nodes.WithLabelValues("net2", "running")))
+	require.Equal(4, testutil.CollectAndCount(metrics.nodes))
+
+	// a busy network isn't scraped, but keeps its last node counts
+	net1.mu.Lock()
+	targets = s.getMetricsTargets()
+	net1.mu.Unlock()
+	require.Equal([]metricsTarget{
+		{networkName: "net2", nodeName: "node1", nodeID: "NodeID-3", uri: "http://127.0.0.1:9660"},
+	}, targets)
+	require.Equal(float64(1), testutil.ToFloat64(metrics.nodes.WithLabelValues("net1", "running")))
+	require.Equal(4, testutil.CollectAndCount(metrics.nodes))
+
+	// a stopped network isn't scraped, and its node counts are dropped
+	_, err = s.Stop(context.Background(), &rpcpb.StopRequest{NetworkName: "net2"})
+	require.NoError(err)
+	targets = s.getMetricsTargets()
+	require.Len(targets, 1)
+	require.Equal(2, testutil.CollectAndCount(metrics.nodes))
+	require.Equal(float64(1), testutil.ToFloat64(metrics.nodes.WithLabelValues("net1", "running")))
+}
@@ -7,25 +7,39 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/DioneProtocol/odyssey-network-runner/local"
 	"github.com/DioneProtocol/odyssey-network-runner/network"
 	"github.com/DioneProtocol/odyssey-network-runner/network/node"
 	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odyssey-network-runner/utils"
 	"github.com/DioneProtocol/odyssey-network-runner/utils/constants"
 	"github.com/DioneProtocol/odyssey-network-runner/ux"
 	"github.com/DioneProtocol/odysseygo/config"
 	"github.com/DioneProtocol/odysseygo/ids"
 	odygo_constants "github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/exp/maps"
 )
 
+// Delegation fee of the initial stakers of generated genesis, in parts per
+// million, if not given
+const defaultGenesisDelegationFee = 10_000
+
+// nDIONE given to each test account on each chain, if not given
+const defaultTestAccountBalance = units.KiloDione
+
 const (
 	prometheusConfFname  = "prometheus.yaml"
 	prometheusConfCommon = `global:
@@ -73,7 +87,15 @@ type localNetwork struct {
 
 	subnets map[string]*rpcpb.SubnetInfo
 
+	// test accounts to fund on start
+	testAccounts     []network.Account
+	testAccountInfos []*rpcpb.TestAccount
+
 	prometheusConfPath string
+
+	// Nodes reported healthy on the event stream since they were last started
+	healthyNodes     set.Set[string]
+	healthyNodesLock sync.Mutex
 }
 
 type chainInfo struct {
@@ -93,6 +115,14 @@ type localNetworkOptions struct {
 	pluginDir         string
 	customNodeConfigs map[string]string
 
+	// snapshot nodes not to load, or to leave paused, and fresh nodes to add
+	// to them, as node name to node config
+	dropNodes        []string
+	pausedNodes      []string
+	extraNodeConfigs map[string]string
+	// load snapshots even if incompatible with the node binaries
+	allowIncompatible bool
+
 	// chain configs to be added to the network, besides the ones in default config, or saved snapshot
 	chainConfigs map[string]string
 	// upgrade configs to be added to the network, besides the ones in default config, or saved snapshot
@@ -102,11 +132,32 @@ type localNetworkOptions struct {
 
 	snapshotsDir string
 
+	snapshotStore local.SnapshotStore
+
 	logLevel logging.Level
 
 	reassignPortsIfUsed bool
 
 	dynamicPorts bool
+
+	faultInjection bool
+
+	restartPolicy node.RestartPolicy
+
+	// if not nil, the genesis is generated from it
+	genesisSpec *rpcpb.GenesisSpec
+	// if not nil, the staking keys of the nodes are derived from it
+	keySeed *int64
+	// number of funded test accounts to create, and their nDIONE on each chain
+	numTestAccounts    uint32
+	testAccountBalance uint64
+
+	// name of the network on the published events
+	networkName string
+	// Published events go here. May be nil.
+	events *eventLog
+	// Operation and node metrics go here. May be nil.
+	metrics *serverMetrics
 }
 
 func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
@@ -131,16 +182,31 @@ func newLocalNetwork(opts localNetworkOptions) (*localNetwork, error) {
 		stopCh:              make(chan struct{}),
 		nodeInfos:           make(map[string]*rpcpb.NodeInfo),
 		subnets:             make(map[string]*rpcpb.SubnetInfo),
+		healthyNodes:        set.Set[string]{},
 	}, nil
 }
 
 // TODO document.
 // Assumes [lc.lock] is held.
 func (lc *localNetwork) createConfig() error {
-	cfg, err := local.NewDefaultConfigNNodes(lc.options.execPath, lc.options.numNodes)
+	var (
+		cfg network.Config
+		err error
+	)
+	if lc.options.keySeed != nil {
+		cfg, err = local.NewDefaultConfigNNodesFromSeed(lc.options.execPath, lc.options.numNodes, *lc.options.keySeed)
+	} else {
+		cfg, err = local.NewDefaultConfigNNodes(lc.options.execPath, lc.options.numNodes)
+	}
 	if err != nil {
 		return err
 	}
+	// the default nodes are only needed as validators of the local genesis
+	if lc.options.keySeed != nil && lc.options.genesisSpec != nil {
+		if err := local.SetNodeKeysFromSeed(&cfg, *lc.options.keySeed, 0); err != nil {
+			return err
+		}
+	}
 
 	var globalConfig map[string]interface{}
 
@@ -192,6 +258,7 @@ func (lc *localNetwork) createConfig() error {
 		cfg.NodeConfigs[i].BinaryPath = lc.execPath
 		cfg.NodeConfigs[i].RedirectStdout = lc.options.redirectNodesOutput
 		cfg.NodeConfigs[i].RedirectStderr = lc.options.redirectNodesOutput
+		cfg.NodeConfigs[i].RestartPolicy = lc.options.restartPolicy
 
 		// set flags applied to the specific node
 		var customNodeConfig map[string]interface{}
@@ -205,16 +272,180 @@ func (lc *localNetwork) createConfig() error {
 		}
 	}
 
+	cfg.FaultInjection = lc.options.faultInjection
+
+	if err := lc.createTestAccounts(); err != nil {
+		return err
+	}
+
+	if lc.options.genesisSpec != nil {
+		if err := lc.createGenesis(&cfg); err != nil {
+			return err
+		}
+	}
+
 	lc.cfg = cfg
 	return nil
 }
 
+// Sets the genesis of [cfg] to the one generated from [lc.options.genesisSpec].
+// The initial stakers default to all the nodes of [cfg].
+func (lc *localNetwork) createGenesis(cfg *network.Config) error {
+	spec := lc.options.genesisSpec
+	genesisConfig := network.GenesisConfig{
+		NetworkID:                  spec.GetNetworkId(),
+		InitialStakeDuration:       spec.GetInitialStakeDuration(),
+		InitialStakeDurationOffset: spec.GetInitialStakeDurationOffset(),
+	}
+	var err error
+	genesisConfig.AChainBalances, err = getGenesisBalances(spec.GetAChainBalances(), parseDioneAddress)
+	if err != nil {
+		return fmt.Errorf("invalid A-chain balances: %w", err)
+	}
+	genesisConfig.OChainBalances, err = getGenesisBalances(spec.GetOChainBalances(), parseDioneAddress)
+	if err != nil {
+		return fmt.Errorf("invalid O-chain balances: %w", err)
+	}
+	genesisConfig.DChainBalances, err = getGenesisBalances(spec.GetDChainBalances(), parseEthAddress)
+	if err != nil {
+		return fmt.Errorf("invalid D-chain balances: %w", err)
+	}
+	balance := new(big.Int).SetUint64(lc.options.testAccountBalance)
+	for _, account := range lc.testAccounts {
+		genesisConfig.AChainBalances = append(genesisConfig.AChainBalances, network.AddrAndBalance{Addr: account.Addr, Balance: balance})
+		genesisConfig.OChainBalances = append(genesisConfig.OChainBalances, network.AddrAndBalance{Addr: account.Addr, Balance: balance})
+		genesisConfig.DChainBalances = append(genesisConfig.DChainBalances, network.AddrAndBalance{
+			Addr:    account.EthAddr,
+			Balance: local.DChainBalance(lc.options.testAccountBalance),
+		})
+	}
+
+	nodeIDs := map[string]ids.NodeID{}
+	nodeNames := []string{}
+	for _, nodeConfig := range cfg.NodeConfigs {
+		nodeID, err := utils.ToNodeID([]byte(nodeConfig.StakingKey), []byte(nodeConfig.StakingCert))
+		if err != nil {
+			return fmt.Errorf("couldn't get node ID of node %q: %w", nodeConfig.Name, err)
+		}
+		nodeIDs[nodeConfig.Name] = nodeID
+		nodeNames = append(nodeNames, nodeConfig.Name)
+	}
+	stakers := spec.GetInitialStakers()
+	if len(stakers) == 0 {
+		stakers = nodeNames
+	}
+	delegationFee := uint32(defaultGenesisDelegationFee)
+	if spec.DelegationFee != nil {
+		delegationFee = spec.GetDelegationFee()
+	}
+	for _, nodeName := range stakers {
+		nodeID, ok := nodeIDs[nodeName]
+		if !ok {
+			return fmt.Errorf("initial staker %q is not a node of the network", nodeName)
+		}
+		genesisConfig.InitialStakers = append(genesisConfig.InitialStakers, network.GenesisStaker{
+			NodeID:        nodeID,
+			DelegationFee: delegationFee,
+		})
+	}
+
+	genesis, err := network.NewGenesis(genesisConfig)
+	if err != nil {
+		return err
+	}
+	cfg.Genesis = string(genesis)
+	return nil
+}
+
+// Creates the test accounts, with the keys given by [lc.options.keySeed],
+// or 0, and their position.
+func (lc *localNetwork) createTestAccounts() error {
+	seed := int64(0)
+	if lc.options.keySeed != nil {
+		seed = *lc.options.keySeed
+	}
+	keys, err := local.NewTestAccountKeysFromSeed(seed, lc.options.numTestAccounts)
+	if err != nil {
+		return err
+	}
+	hrp := odygo_constants.GetHRP(odygo_constants.LocalID)
+	if lc.options.genesisSpec != nil {
+		hrp = odygo_constants.GetHRP(lc.options.genesisSpec.GetNetworkId())
+	}
+	lc.testAccounts = []network.Account{}
+	lc.testAccountInfos = []*rpcpb.TestAccount{}
+	for _, key := range keys {
+		account := local.NewAccount(key)
+		aChainAddr, err := address.Format("A", hrp, account.Addr[:])
+		if err != nil {
+			return err
+		}
+		oChainAddr, err := address.Format("O", hrp, account.Addr[:])
+		if err != nil {
+			return err
+		}
+		lc.testAccounts = append(lc.testAccounts, account)
+		lc.testAccountInfos = append(lc.testAccountInfos, &rpcpb.TestAccount{
+			PrivateKey:    key.String(),
+			AChainAddress: aChainAddr,
+			OChainAddress: oChainAddr,
+			DChainAddress: common.Address(account.EthAddr).Hex(),
+		})
+	}
+	return nil
+}
+
+// Returns the balances of [balances], a map from address to decimal balance,
+// sorted by address.
+func getGenesisBalances(balances map[string]string, parseAddress func(string) (ids.ShortID, error)) ([]network.AddrAndBalance, error) {
+	addrs := maps.Keys(balances)
+	sort.Strings(addrs)
+	addrBalances := []network.AddrAndBalance{}
+	for _, addr := range addrs {
+		parsedAddr, err := parseAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+		balance, ok := new(big.Int).SetString(balances[addr], 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance %q for address %q", balances[addr], addr)
+		}
+		addrBalances = append(addrBalances, network.AddrAndBalance{
+			Addr:    parsedAddr,
+			Balance: balance,
+		})
+	}
+	return addrBalances, nil
+}
+
+// Parses a bech32 address, with or without chain alias.
+func parseDioneAddress(addr string) (ids.ShortID, error) {
+	if strings.Contains(addr, "-") {
+		return address.ParseToID(addr)
+	}
+	_, addrBytes, err := address.ParseBech32(addr)
+	if err != nil {
+		return ids.ShortID{}, err
+	}
+	return ids.ToShortID(addrBytes)
+}
+
+// Parses a hex address, with or without 0x prefix.
+func parseEthAddress(addr string) (ids.ShortID, error) {
+	if !common.IsHexAddress(addr) {
+		return ids.ShortID{}, fmt.Errorf("not a hex address")
+	}
+	return ids.ShortID(common.HexToAddress(addr)), nil
+}
+
 // Creates a network and sets [lc.nw] to it.
 // Assumes [lc.lock] isn't held.
 func (lc *localNetwork) Start(ctx context.Context) error {
 	lc.lock.Lock()
 	defer lc.lock.Unlock()
 
+	start := time.Now()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -233,21 +464,31 @@ func (lc *localNetwork) Start(ctx context.Context) error {
 	}
 
 	ux.Print(lc.log, logging.Blue.Wrap(logging.Bold.Wrap("create and run local network")))
-	nw, err := local.NewNetwork(lc.log, lc.cfg, lc.options.rootDataDir, lc.options.snapshotsDir, lc.options.reassignPortsIfUsed)
+	nw, err := local.NewNetwork(lc.log, lc.cfg, lc.options.rootDataDir, lc.options.snapshotsDir, lc.options.snapshotStore, lc.options.reassignPortsIfUsed)
 	if err != nil {
 		return err
 	}
 	lc.nw = nw
+	go lc.forwardNodeEvents(nw)
 
 	// node info is already available
 	if err := lc.updateNodeInfo(); err != nil {
 		return err
 	}
+	lc.publishStartedNodes()
 
 	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
 		return err
 	}
 
+	// with a genesis spec, the test accounts are funded on genesis
+	if lc.options.genesisSpec == nil {
+		if err := lc.nw.FundAccounts(ctx, lc.testAccounts, lc.options.testAccountBalance); err != nil {
+			return err
+		}
+	}
+
+	lc.observeOperation(operationStart, start)
 	return nil
 }
 
@@ -281,6 +522,9 @@ func (lc *localNetwork) CreateChains(
 		return nil, err
 	}
 
+	start := time.Now()
+	subnetIDs := maps.Keys(lc.subnets)
+
 	chainIDs, err := lc.nw.CreateBlockchains(ctx, chainSpecs)
 	if err != nil {
 		return nil, err
@@ -290,6 +534,19 @@ func (lc *localNetwork) CreateChains(
 		return nil, err
 	}
 
+	lc.publishNewSubnets(subnetIDs)
+	for _, chainID := range chainIDs {
+		event := &rpcpb.Event{
+			Type:         rpcpb.EventType_EVENT_TYPE_BLOCKCHAIN_CREATED,
+			BlockchainId: chainID.String(),
+		}
+		if chainInfo, ok := lc.customChainIDToInfo[chainID]; ok {
+			event.SubnetId = chainInfo.subnetID.String()
+		}
+		lc.publishEvent(event)
+	}
+
+	lc.observeOperation(operationCreateChains, start)
 	return chainIDs, nil
 }
 
@@ -328,10 +585,93 @@ func (lc *localNetwork) AddPermissionlessValidators(ctx context.Context, validat
 		return err
 	}
 
+	for _, validatorSpec := range validatorSpecs {
+		lc.publishEvent(&rpcpb.Event{
+			Type:     rpcpb.EventType_EVENT_TYPE_VALIDATOR_ADDED,
+			NodeName: validatorSpec.NodeName,
+			SubnetId: validatorSpec.SubnetID,
+		})
+	}
+
 	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap("finished adding permissionless validators")))
 	return nil
 }
 
+func (lc *localNetwork) AddPermissionlessDelegators(ctx context.Context, delegatorSpecs []network.PermissionlessDelegatorSpec) ([]ids.ID, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func(ctx context.Context) {
+		select {
+		case <-lc.stopCh:
+			// The network is stopped; return from method calls below.
+			cancel()
+		case <-ctx.Done():
+			// This method is done. Don't leak [ctx].
+		}
+	}(ctx)
+
+	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	txIDs, err := lc.nw.AddPermissionlessDelegators(ctx, delegatorSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegatorSpec := range delegatorSpecs {
+		lc.publishEvent(&rpcpb.Event{
+			Type:     rpcpb.EventType_EVENT_TYPE_DELEGATOR_ADDED,
+			NodeName: delegatorSpec.NodeName,
+			SubnetId: delegatorSpec.SubnetID,
+		})
+	}
+
+	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap("finished adding permissionless delegators")))
+	return txIDs, nil
+}
+
+func (lc *localNetwork) AddPrimaryDelegators(ctx context.Context, delegatorSpecs []network.PrimaryDelegatorSpec) ([]ids.ID, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func(ctx context.Context) {
+		select {
+		case <-lc.stopCh:
+			// The network is stopped; return from method calls below.
+			cancel()
+		case <-ctx.Done():
+			// This method is done. Don't leak [ctx].
+		}
+	}(ctx)
+
+	if err := lc.awaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+		return nil, err
+	}
+
+	txIDs, err := lc.nw.AddPrimaryDelegators(ctx, delegatorSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegatorSpec := range delegatorSpecs {
+		lc.publishEvent(&rpcpb.Event{
+			Type:     rpcpb.EventType_EVENT_TYPE_DELEGATOR_ADDED,
+			NodeName: delegatorSpec.NodeName,
+		})
+	}
+
+	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap("finished adding primary delegators")))
+	return txIDs, nil
+}
+
 func (lc *localNetwork) RemoveSubnetValidator(ctx context.Context, validatorSpecs []network.RemoveSubnetValidatorSpec) error {
 	lc.lock.Lock()
 	defer lc.lock.Unlock()
@@ -367,6 +707,16 @@ func (lc *localNetwork) RemoveSubnetValidator(ctx context.Context, validatorSpec
 		return err
 	}
 
+	for _, validatorSpec := range validatorSpecs {
+		for _, nodeName := range validatorSpec.NodeNames {
+			lc.publishEvent(&rpcpb.Event{
+				Type:     rpcpb.EventType_EVENT_TYPE_VALIDATOR_REMOVED,
+				NodeName: nodeName,
+				SubnetId: validatorSpec.SubnetID,
+			})
+		}
+	}
+
 	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap("finished removing subnet validators")))
 	return nil
 }
@@ -438,6 +788,9 @@ func (lc *localNetwork) CreateSubnets(ctx context.Context, subnetSpecs []network
 		return nil, err
 	}
 
+	start := time.Now()
+	prevSubnetIDs := maps.Keys(lc.subnets)
+
 	subnetIDs, err := lc.nw.CreateSubnets(ctx, subnetSpecs)
 	if err != nil {
 		return nil, err
@@ -447,6 +800,9 @@ func (lc *localNetwork) CreateSubnets(ctx context.Context, subnetSpecs []network
 		return nil, err
 	}
 
+	lc.publishNewSubnets(prevSubnetIDs)
+	lc.observeOperation(operationCreateSubnets, start)
+
 	ux.Print(lc.log, logging.Green.Wrap(logging.Bold.Wrap("finished adding subnets")))
 	return subnetIDs, nil
 }
@@ -458,6 +814,7 @@ func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
 	defer lc.lock.Unlock()
 
 	ux.Print(lc.log, logging.Blue.Wrap(logging.Bold.Wrap("create and run local network from snapshot")))
+	start := time.Now()
 
 	var globalNodeConfig map[string]interface{}
 	if lc.options.globalNodeConfig != "" {
@@ -466,31 +823,69 @@ func (lc *localNetwork) LoadSnapshot(snapshotName string) error {
 		}
 	}
 
+	topology, err := lc.createSnapshotTopology()
+	if err != nil {
+		return err
+	}
+
 	nw, err := local.NewNetworkFromSnapshot(
 		lc.log,
 		snapshotName,
 		lc.options.rootDataDir,
 		lc.options.snapshotsDir,
+		lc.options.snapshotStore,
 		lc.execPath,
 		lc.pluginDir,
 		lc.options.chainConfigs,
 		lc.options.upgradeConfigs,
 		lc.options.subnetConfigs,
 		globalNodeConfig,
+		topology,
+		lc.options.allowIncompatible,
 		lc.options.reassignPortsIfUsed,
 	)
 	if err != nil {
 		return err
 	}
 	lc.nw = nw
+	go lc.forwardNodeEvents(nw)
 
 	if err := lc.updateNodeInfo(); err != nil {
 		return err
 	}
+	lc.publishStartedNodes()
 
+	lc.observeOperation(operationLoadSnapshot, start)
 	return nil
 }
 
+// Returns the snapshot topology given by the network options. Extra nodes
+// are sorted by name.
+func (lc *localNetwork) createSnapshotTopology() (local.SnapshotTopology, error) {
+	topology := local.SnapshotTopology{
+		DropNodes:   lc.options.dropNodes,
+		PausedNodes: lc.options.pausedNodes,
+	}
+	extraNodeNames := maps.Keys(lc.options.extraNodeConfigs)
+	sort.Strings(extraNodeNames)
+	for _, nodeName := range extraNodeNames {
+		nodeConfig := node.Config{
+			Name:           nodeName,
+			Flags:          map[string]interface{}{},
+			RedirectStdout: lc.options.redirectNodesOutput,
+			RedirectStderr: lc.options.redirectNodesOutput,
+			RestartPolicy:  lc.options.restartPolicy,
+		}
+		if extraNodeConfig := lc.options.extraNodeConfigs[nodeName]; extraNodeConfig != "" {
+			if err := json.Unmarshal([]byte(extraNodeConfig), &nodeConfig.Flags); err != nil {
+				return topology, fmt.Errorf("failure parsing config of extra node %q: %w", nodeName, err)
+			}
+		}
+		topology.ExtraNodes = append(topology.ExtraNodes, nodeConfig)
+	}
+	return topology, nil
+}
+
 // Populates [lc.customChainIDToInfo] for all chains other than those on
 // the Primary Network (O-Chain, A-Chain, D-Chain.)
 // Populates [lc.subnets] with all subnets that exist.
@@ -626,9 +1021,11 @@ func (lc *localNetwork) AwaitHealthyAndUpdateNetworkInfo(ctx context.Context) er
 func (lc *localNetwork) awaitHealthyAndUpdateNetworkInfo(ctx context.Context) error {
 	ux.Print(lc.log, logging.Blue.Wrap(logging.Bold.Wrap("waiting for all nodes to report healthy...")))
 
+	start := time.Now()
 	if err := lc.nw.Healthy(ctx); err != nil {
 		return err
 	}
+	lc.observeOperation(operationAwaitHealthy, start)
 
 	if err := lc.updateNodeInfo(); err != nil {
 		return err
@@ -648,6 +1045,7 @@ func (lc *localNetwork) awaitHealthyAndUpdateNetworkInfo(ctx context.Context) er
 		lc.log.Debug(fmt.Sprintf(logging.Cyan.Wrap("node-info: node-name %s, node-ID: %s, URI: %s"), nodeName, nodeInfo.Id, nodeInfo.Uri))
 	}
 
+	lc.publishHealthyNodes()
 	return nil
 }
 
@@ -659,23 +1057,57 @@ func (lc *localNetwork) UpdateNodeInfo() error {
 	return lc.updateNodeInfo()
 }
 
+// Returns the current node infos, without updating [lc.nodeInfos].
+// Assumes [lc.lock] isn't held.
+func (lc *localNetwork) GetNodeInfos() (map[string]*rpcpb.NodeInfo, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	return lc.getNodeInfos()
+}
+
 // Populates [lc.nodeNames] and [lc.nodeInfos] for
 // all nodes in this network.
 // Assumes [lc.lock] is held.
 func (lc *localNetwork) updateNodeInfo() error {
-	nodes, err := lc.nw.GetAllNodes()
+	nodeInfos, err := lc.getNodeInfos()
 	if err != nil {
 		return err
 	}
+	lc.nodeInfos = nodeInfos
+
+	for _, nodeInfo := range lc.nodeInfos {
+		// update default exec and pluginDir if empty (snapshots started without these params)
+		if lc.execPath == "" {
+			lc.execPath = nodeInfo.ExecPath
+		}
+		if lc.pluginDir == "" {
+			lc.pluginDir = nodeInfo.PluginDir
+		}
+	}
+	return lc.generatePrometheusConf()
+}
+
+// Assumes [lc.lock] is held.
+func (lc *localNetwork) getNodeInfos() (map[string]*rpcpb.NodeInfo, error) {
+	nodes, err := lc.nw.GetAllNodes()
+	if err != nil {
+		return nil, err
+	}
 
-	lc.nodeInfos = make(map[string]*rpcpb.NodeInfo)
+	nodeInfos := make(map[string]*rpcpb.NodeInfo)
 	for name, node := range nodes {
 		trackSubnets, err := node.GetFlag(config.TrackSubnetsKey)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		flags, err := json.Marshal(node.GetConfig().Flags)
+		if err != nil {
+			return nil, err
 		}
 
-		lc.nodeInfos[name] = &rpcpb.NodeInfo{
+		nodeInfos[name] = &rpcpb.NodeInfo{
 			Name:               node.GetName(),
 			Uri:                fmt.Sprintf("http://%s:%d", node.GetURL(), node.GetAPIPort()),
 			Id:                 node.GetNodeID().String(),
@@ -686,17 +1118,31 @@ func (lc *localNetwork) updateNodeInfo() error {
 			PluginDir:          node.GetPluginDir(),
 			WhitelistedSubnets: trackSubnets,
 			Paused:             node.GetPaused(),
+			RestartCount:       node.GetRestartCount(),
+			LastExitCode:       int32(node.GetLastExitCode()),
+			Flags:              flags,
 		}
-
-		// update default exec and pluginDir if empty (snapshots started without these params)
-		if lc.execPath == "" {
-			lc.execPath = node.GetBinaryPath()
+		for _, usage := range node.GetResourceUsage() {
+			nodeInfos[name].ResourceHistory = append(nodeInfos[name].ResourceHistory, getRPCResourceUsage(usage))
 		}
-		if lc.pluginDir == "" {
-			lc.pluginDir = node.GetPluginDir()
+		if history := nodeInfos[name].ResourceHistory; len(history) > 0 {
+			nodeInfos[name].ResourceUsage = history[len(history)-1]
 		}
 	}
-	return lc.generatePrometheusConf()
+	return nodeInfos, nil
+}
+
+func getRPCResourceUsage(usage node.ResourceUsage) *rpcpb.ResourceUsage {
+	return &rpcpb.ResourceUsage{
+		Timestamp:    usage.Time.UnixMilli(),
+		CpuPercent:   usage.CPUPercent,
+		RssBytes:     usage.RSSBytes,
+		NumFds:       usage.NumFDs,
+		NumThreads:   usage.NumThreads,
+		ReadBytes:    usage.ReadBytes,
+		WriteBytes:   usage.WriteBytes,
+		NumProcesses: usage.NumProcesses,
+	}
 }
 
 func (lc *localNetwork) generatePrometheusConf() error {
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odyssey-network-runner/local"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) Prune(ctx context.Context, req *rpcpb.PruneRequest) (*rpcpb.PruneResponse, error) {
+	s.log.Info("Prune", zap.String("root-data-dir", req.RootDataDir), zap.Bool("dry-run", req.DryRun))
+
+	rootDirs, size, err := local.PruneNetworkRootDirs(req.RootDataDir, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.PruneResponse{
+		RemovedRootDirs:  rootDirs,
+		ReclaimedBytes:   uint64(size),
+		RemovedSnapshots: []string{},
+	}
+	removed, err := local.ApplySnapshotRetention(ctx, s.snapshotStore, s.cfg.SnapshotsDir, s.cfg.SnapshotRetention, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	for _, metadata := range removed {
+		resp.RemovedSnapshots = append(resp.RemovedSnapshots, metadata.SnapshotName)
+	}
+	if !req.DryRun {
+		s.log.Info("pruned",
+			zap.Int("root-dirs", len(rootDirs)),
+			zap.Uint64("reclaimed-bytes", resp.ReclaimedBytes),
+			zap.Strings("snapshots", resp.RemovedSnapshots),
+		)
+	}
+	return resp, nil
+}
+
+// Removes the snapshots that don't fit the retention of the server.
+// Failures are only logged, as they don't affect the calling operation.
+func (s *server) applySnapshotRetention(ctx context.Context) {
+	removed, err := local.ApplySnapshotRetention(ctx, s.snapshotStore, s.cfg.SnapshotsDir, s.cfg.SnapshotRetention, false)
+	for _, metadata := range removed {
+		s.log.Info("snapshot removed by retention",
+			zap.String("snapshot-name", metadata.SnapshotName),
+			zap.Time("created-at", metadata.CreatedAt),
+		)
+	}
+	if err != nil {
+		s.log.Warn("snapshot retention failed", zap.Error(err))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -21,11 +22,12 @@ import (
 
 	"go.uber.org/multierr"
 
+	"github.com/DioneProtocol/odyssey-network-runner/chaos"
+	"github.com/DioneProtocol/odyssey-network-runner/local"
 	"github.com/DioneProtocol/odyssey-network-runner/network"
 	"github.com/DioneProtocol/odyssey-network-runner/network/node"
 	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
 	"github.com/DioneProtocol/odyssey-network-runner/utils"
-	"github.com/DioneProtocol/odyssey-network-runner/utils/constants"
 	"github.com/DioneProtocol/odysseygo/config"
 	"github.com/DioneProtocol/odysseygo/message"
 	"github.com/DioneProtocol/odysseygo/snow/networking/router"
@@ -52,6 +54,7 @@ const (
 	waitForHealthyTimeout = 3 * time.Minute
 
 	networkRootDirPrefix   = "network"
+	DefaultNetworkName     = "default"
 	TimeParseLayout        = "2006-01-02 15:04:05"
 	StakingMinimumLeadTime = 25 * time.Second
 )
@@ -69,6 +72,12 @@ var (
 	ErrNoSubnetID             = errors.New("subnetID is missing")
 	ErrNoElasticSubnetSpec    = errors.New("no elastic subnet spec was provided")
 	ErrNoValidatorSpec        = errors.New("no validator spec was provided")
+	ErrNoDelegatorSpec        = errors.New("no delegator spec was provided")
+	ErrInvalidNetworkName     = errors.New("invalid network name")
+	ErrChaosRunning           = errors.New("chaos is already running")
+	ErrChaosNotStarted        = errors.New("chaos was not started")
+
+	networkNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
 )
 
 type Config struct {
@@ -79,7 +88,14 @@ type Config struct {
 	DialTimeout         time.Duration
 	RedirectNodesOutput bool
 	SnapshotsDir        string
-	LogLevel            logging.Level
+	// Where snapshots are kept, see local.NewSnapshotStore.
+	// Defaults to SnapshotsDir if empty.
+	SnapshotStore string
+	// Applied on snapshot save and import, and on prune
+	SnapshotRetention local.SnapshotRetention
+	LogLevel          logging.Level
+	// Version of the runner, recorded on exported snapshots
+	RunnerVersion string
 }
 
 type Server interface {
@@ -102,6 +118,30 @@ type server struct {
 	gwMux    *runtime.ServeMux
 	gwServer *http.Server
 
+	// Maps from the network name to its state.
+	// [mu] guards the map itself, each entry is guarded by its own lock.
+	// Entries are removed once their network is stopped, or fails to start.
+	// [mu] is always acquired before the lock of an entry.
+	networks map[string]*networkState
+
+	// Lifecycle events of all the networks
+	events *eventLog
+
+	// Shared by all the networks
+	snapshotStore local.SnapshotStore
+
+	metrics *serverMetrics
+
+	rpcpb.UnimplementedPingServiceServer
+	rpcpb.UnimplementedControlServiceServer
+}
+
+// networkState holds the server side state of a single named network.
+type networkState struct {
+	mu *sync.RWMutex
+
+	name string
+
 	clusterInfo *rpcpb.ClusterInfo
 	// Controls running nodes.
 	// Invariant: If [network] is non-nil, then [clusterInfo] is non-nil.
@@ -109,8 +149,20 @@ type server struct {
 	network    *localNetwork
 	asyncErrCh chan error
 
-	rpcpb.UnimplementedPingServiceServer
-	rpcpb.UnimplementedControlServiceServer
+	// Last chaos scheduler started on the network, kept after it finishes
+	// so its timeline can be fetched.
+	chaos *chaos.Scheduler
+
+	// True once removed from [server.networks], so it is not started again
+	removed bool
+}
+
+func newNetworkState(name string) *networkState {
+	return &networkState{
+		mu:         new(sync.RWMutex),
+		name:       name,
+		asyncErrCh: make(chan error, 1),
+	}
 }
 
 // grpc encapsulates the non protocol-related, ONR server domain errors,
@@ -128,19 +180,32 @@ func New(cfg Config, log logging.Logger) (Server, error) {
 		return nil, ErrInvalidPort
 	}
 
+	metrics, err := newServerMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotStore, err := local.NewSnapshotStore(log, cfg.SnapshotStore, cfg.SnapshotsDir)
+	if err != nil {
+		return nil, err
+	}
+
 	listener, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		return nil, err
 	}
 
 	s := &server{
-		cfg:        cfg,
-		log:        log,
-		closed:     make(chan struct{}),
-		ln:         listener,
-		gRPCServer: grpc.NewServer(),
-		mu:         new(sync.RWMutex),
-		asyncErrCh: make(chan error, 1),
+		cfg:           cfg,
+		log:           log,
+		closed:        make(chan struct{}),
+		ln:            listener,
+		gRPCServer:    grpc.NewServer(grpc.UnaryInterceptor(metrics.unaryInterceptor)),
+		mu:            new(sync.RWMutex),
+		networks:      map[string]*networkState{},
+		events:        newEventLog(),
+		metrics:       metrics,
+		snapshotStore: snapshotStore,
 	}
 	if !cfg.GwDisabled {
 		s.gwMux = runtime.NewServeMux()
@@ -195,6 +260,10 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 				gwErrChan <- err
 				return
 			}
+			if err := s.gwMux.HandlePath(http.MethodGet, metricsPath, s.serveMetrics); err != nil {
+				gwErrChan <- err
+				return
+			}
 
 			s.log.Info("serving gRPC gateway", zap.String("port", s.cfg.GwPort))
 			gwErrChan <- s.gwServer.ListenAndServe()
@@ -232,14 +301,18 @@ func (s *server) Run(rootCtx context.Context) (err error) {
 		<-gRPCErrChan // Wait for [s.gRPCServer.Serve] to return.
 	}
 
-	// Grab lock to ensure [s.network] isn't being used.
+	// Grab locks to ensure no network is being used.
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.network != nil {
-		// Close the network.
-		s.stopAndRemoveNetwork(nil)
-		s.log.Warn("network stopped")
+	for _, ns := range s.networks {
+		ns.mu.Lock()
+		if ns.network != nil {
+			// Close the network.
+			s.stopAndRemoveNetwork(ns, nil)
+			s.log.Warn("network stopped", zap.String("network-name", ns.name))
+		}
+		ns.mu.Unlock()
 	}
 
 	s.rootCancel()
@@ -258,11 +331,14 @@ func (s *server) RPCVersion(context.Context, *rpcpb.RPCVersionRequest) (*rpcpb.R
 }
 
 func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.StartResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns, err := s.getOrCreateNetworkState(req.GetNetworkName())
+	if err != nil {
+		return nil, err
+	}
+	defer s.unlockNetworkState(ns)
 
 	// If [network] is already populated, the network has already been started.
-	if s.network != nil {
+	if ns.network != nil {
 		return nil, ErrAlreadyBootstrapped
 	}
 
@@ -300,18 +376,9 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		pid               = int32(os.Getpid())
 		globalNodeConfig  = req.GetGlobalNodeConfig()
 		customNodeConfigs = req.GetCustomNodeConfigs()
-		err               error
 	)
 
-	if len(rootDataDir) == 0 {
-		rootDataDir = filepath.Join(os.TempDir(), constants.RootDirPrefix)
-		err = os.MkdirAll(rootDataDir, os.ModePerm)
-		if err != nil {
-			return nil, err
-		}
-	}
-	rootDataDir = filepath.Join(rootDataDir, networkRootDirPrefix)
-	rootDataDir, err = utils.MkDirWithTimestamp(rootDataDir)
+	rootDataDir, err = local.NewNetworkRootDir(rootDataDir, getNetworkRootDirPrefix(ns.name))
 	if err != nil {
 		return nil, err
 	}
@@ -321,12 +388,18 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		numNodes = uint32(len(customNodeConfigs))
 	}
 
-	s.clusterInfo = &rpcpb.ClusterInfo{
+	testAccountBalance := defaultTestAccountBalance
+	if req.TestAccountBalance != nil {
+		testAccountBalance = req.GetTestAccountBalance()
+	}
+
+	ns.clusterInfo = &rpcpb.ClusterInfo{
 		Pid:         pid,
 		RootDataDir: rootDataDir,
+		NetworkName: ns.name,
 	}
 
-	s.network, err = newLocalNetwork(localNetworkOptions{
+	ns.network, err = newLocalNetwork(localNetworkOptions{
 		execPath:            execPath,
 		rootDataDir:         rootDataDir,
 		numNodes:            numNodes,
@@ -341,13 +414,24 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		logLevel:            s.cfg.LogLevel,
 		reassignPortsIfUsed: req.GetReassignPortsIfUsed(),
 		dynamicPorts:        req.GetDynamicPorts(),
+		faultInjection:      req.GetFaultInjection(),
+		restartPolicy:       getRestartPolicy(req.GetRestartPolicy()),
+		genesisSpec:         req.GetGenesisSpec(),
+		keySeed:             req.KeySeed,
+		numTestAccounts:     req.GetNumTestAccounts(),
+		testAccountBalance:  testAccountBalance,
 		snapshotsDir:        s.cfg.SnapshotsDir,
+		snapshotStore:       s.snapshotStore,
+		networkName:         ns.name,
+		events:              s.events,
+		metrics:             s.metrics,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	s.log.Info("starting",
+		zap.String("network-name", ns.name),
 		zap.String("exec-path", execPath),
 		zap.Uint32("num-nodes", numNodes),
 		zap.String("track-subnets", trackSubnets),
@@ -360,21 +444,21 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	if err := s.network.Start(ctx); err != nil {
+	if err := ns.network.Start(ctx); err != nil {
 		s.log.Warn("start failed to complete", zap.Error(err))
-		s.stopAndRemoveNetwork(nil)
+		s.stopAndRemoveNetwork(ns, nil)
 		return nil, err
 	}
 
 	ctx, cancel = context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	chainIDs, err := s.network.CreateChains(ctx, chainSpecs)
+	chainIDs, err := ns.network.CreateChains(ctx, chainSpecs)
 	if err != nil {
 		s.log.Error("network never became healthy", zap.Error(err))
-		s.stopAndRemoveNetwork(err)
+		s.stopAndRemoveNetwork(ns, err)
 		return nil, err
 	}
-	s.updateClusterInfo()
+	s.updateClusterInfo(ns)
 	s.log.Info("network healthy")
 
 	strChainIDs := []string{}
@@ -382,78 +466,166 @@ func (s *server) Start(_ context.Context, req *rpcpb.StartRequest) (*rpcpb.Start
 		strChainIDs = append(strChainIDs, chainID.String())
 	}
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.StartResponse{ClusterInfo: clusterInfo, ChainIds: strChainIDs}, nil
+	return &rpcpb.StartResponse{
+		ClusterInfo:  clusterInfo,
+		ChainIds:     strChainIDs,
+		TestAccounts: clusterInfo.TestAccounts,
+	}, nil
 }
 
-// Asssumes [s.mu] is held.
-func (s *server) updateClusterInfo() {
-	if s.network == nil {
+// Asssumes [ns.mu] is held.
+func (s *server) updateClusterInfo(ns *networkState) {
+	if ns.network == nil {
 		// stop may have been called
 		return
 	}
-	s.clusterInfo.Healthy = true
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
-	s.clusterInfo.CustomChainsHealthy = true
-	s.clusterInfo.CustomChains = make(map[string]*rpcpb.CustomChainInfo)
-	for chainID, chainInfo := range s.network.customChainIDToInfo {
-		s.clusterInfo.CustomChains[chainID.String()] = chainInfo.info
+	ns.clusterInfo.Healthy = true
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
+	ns.clusterInfo.CustomChainsHealthy = true
+	ns.clusterInfo.CustomChains = make(map[string]*rpcpb.CustomChainInfo)
+	for chainID, chainInfo := range ns.network.customChainIDToInfo {
+		ns.clusterInfo.CustomChains[chainID.String()] = chainInfo.info
+	}
+	ns.clusterInfo.Subnets = ns.network.subnets
+	ns.clusterInfo.TestAccounts = ns.network.testAccountInfos
+}
+
+// Returns the network name to use for a request, applying the default if empty.
+func getNetworkName(networkName string) string {
+	if networkName == "" {
+		return DefaultNetworkName
+	}
+	return networkName
+}
+
+// Returns the root data dir prefix for the given network. The default
+// network keeps the legacy prefix.
+func getNetworkRootDirPrefix(networkName string) string {
+	if networkName == DefaultNetworkName {
+		return networkRootDirPrefix
+	}
+	return networkRootDirPrefix + "-" + networkName
+}
+
+// Returns the state of the network named [networkName]. If no such network
+// is registered, an empty state is returned, so callers fail with
+// [ErrNotBootstrapped] when checking it.
+func (s *server) getNetworkState(networkName string) *networkState {
+	networkName = getNetworkName(networkName)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if ns, ok := s.networks[networkName]; ok {
+		return ns
+	}
+	return newNetworkState(networkName)
+}
+
+// Returns the state of the network named [networkName], registering
+// a new one if it does not exist yet.
+// The state is returned locked, and is to be released with
+// [unlockNetworkState].
+func (s *server) getOrCreateNetworkState(networkName string) (*networkState, error) {
+	networkName = getNetworkName(networkName)
+	if !networkNameRegexp.MatchString(networkName) {
+		return nil, fmt.Errorf("%w %q", ErrInvalidNetworkName, networkName)
+	}
+
+	for {
+		s.mu.Lock()
+		ns, ok := s.networks[networkName]
+		if !ok {
+			ns = newNetworkState(networkName)
+			s.networks[networkName] = ns
+		}
+		s.mu.Unlock()
+
+		ns.mu.Lock()
+		if !ns.removed {
+			return ns, nil
+		}
+		// removed while waiting for its lock
+		ns.mu.Unlock()
+	}
+}
+
+// Unlocks [ns], and removes it from [s.networks] if its network is not
+// running, e.g. it was stopped or failed to start.
+// Assumes [ns.mu] is held, and [s.mu] isn't.
+func (s *server) unlockNetworkState(ns *networkState) {
+	running := ns.network != nil
+	ns.mu.Unlock()
+	if running {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	// it may have been started again in between
+	if ns.network == nil && s.networks[ns.name] == ns {
+		delete(s.networks, ns.name)
+		ns.removed = true
 	}
-	s.clusterInfo.Subnets = s.network.subnets
 }
 
 // wait until some of this conditions is met:
 // - timeout expires
 // - network operation terminates with error
 // - network operation terminates successfully by setting CustomChainsHealthy
-func (s *server) WaitForHealthy(ctx context.Context, _ *rpcpb.WaitForHealthyRequest) (*rpcpb.WaitForHealthyResponse, error) {
+func (s *server) WaitForHealthy(ctx context.Context, req *rpcpb.WaitForHealthyRequest) (*rpcpb.WaitForHealthyResponse, error) {
 	s.log.Debug("WaitForHealthy")
 
 	ctx, cancel := context.WithTimeout(ctx, waitForHealthyTimeout)
 	defer cancel()
 
+	ns := s.getNetworkState(req.GetNetworkName())
 	for {
-		s.mu.RLock()
-		if s.clusterInfo == nil {
-			defer s.mu.RUnlock()
+		ns.mu.RLock()
+		if ns.clusterInfo == nil {
+			defer ns.mu.RUnlock()
 			return nil, ErrNotBootstrapped
 		}
-		if s.clusterInfo.CustomChainsHealthy {
-			defer s.mu.RUnlock()
-			clusterInfo, err := deepCopy(s.clusterInfo)
+		if ns.clusterInfo.CustomChainsHealthy {
+			defer ns.mu.RUnlock()
+			clusterInfo, err := deepCopy(ns.clusterInfo)
 			if err != nil {
 				return nil, err
 			}
 			return &rpcpb.WaitForHealthyResponse{ClusterInfo: clusterInfo}, nil
 		}
 		select {
-		case err := <-s.asyncErrCh:
-			defer s.mu.RUnlock()
-			clusterInfo, deepCopyErr := deepCopy(s.clusterInfo)
+		case err := <-ns.asyncErrCh:
+			defer ns.mu.RUnlock()
+			clusterInfo, deepCopyErr := deepCopy(ns.clusterInfo)
 			if deepCopyErr != nil {
 				err = multierr.Append(err, deepCopyErr)
 				return nil, err
 			}
 			return &rpcpb.WaitForHealthyResponse{ClusterInfo: clusterInfo}, err
 		case <-ctx.Done():
-			defer s.mu.RUnlock()
-			clusterInfo, err := deepCopy(s.clusterInfo)
+			defer ns.mu.RUnlock()
+			clusterInfo, err := deepCopy(ns.clusterInfo)
 			if err != nil {
 				return nil, err
 			}
 			return &rpcpb.WaitForHealthyResponse{ClusterInfo: clusterInfo}, ctx.Err()
 		default:
 		}
-		if s.network == nil {
-			defer s.mu.RUnlock()
+		if ns.network == nil {
+			defer ns.mu.RUnlock()
 			return nil, ErrNotBootstrapped
 		}
-		s.mu.RUnlock()
+		ns.mu.RUnlock()
 		time.Sleep(1 * time.Second)
 	}
 }
@@ -462,10 +634,11 @@ func (s *server) CreateBlockchains(
 	_ context.Context,
 	req *rpcpb.CreateBlockchainsRequest,
 ) (*rpcpb.CreateBlockchainsResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -477,7 +650,7 @@ func (s *server) CreateBlockchains(
 
 	chainSpecs := []network.BlockchainSpec{}
 	for _, spec := range req.GetBlockchainSpecs() {
-		chainSpec, err := getNetworkBlockchainSpec(s.log, spec, false, s.network.pluginDir)
+		chainSpec, err := getNetworkBlockchainSpec(s.log, spec, false, ns.network.pluginDir)
 		if err != nil {
 			return nil, err
 		}
@@ -486,7 +659,7 @@ func (s *server) CreateBlockchains(
 
 	// check that the given subnets exist
 	subnetsSet := set.Set[string]{}
-	subnetIDsList := maps.Keys(s.clusterInfo.Subnets)
+	subnetIDsList := maps.Keys(ns.clusterInfo.Subnets)
 	subnetsSet.Add(subnetIDsList...)
 
 	for _, chainSpec := range chainSpecs {
@@ -495,18 +668,18 @@ func (s *server) CreateBlockchains(
 		}
 	}
 
-	s.clusterInfo.Healthy = false
-	s.clusterInfo.CustomChainsHealthy = false
+	ns.clusterInfo.Healthy = false
+	ns.clusterInfo.CustomChainsHealthy = false
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	chainIDs, err := s.network.CreateChains(ctx, chainSpecs)
+	chainIDs, err := ns.network.CreateChains(ctx, chainSpecs)
 	if err != nil {
 		s.log.Error("failed to create blockchains", zap.Error(err))
-		s.stopAndRemoveNetwork(err)
+		s.stopAndRemoveNetwork(ns, err)
 		return nil, err
 	} else {
-		s.updateClusterInfo()
+		s.updateClusterInfo(ns)
 	}
 	s.log.Info("custom chains created")
 
@@ -515,7 +688,7 @@ func (s *server) CreateBlockchains(
 		strChainIDs = append(strChainIDs, chainID.String())
 	}
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -526,10 +699,11 @@ func (s *server) AddPermissionlessValidator(
 	_ context.Context,
 	req *rpcpb.AddPermissionlessValidatorRequest,
 ) (*rpcpb.AddPermissionlessValidatorResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -550,7 +724,7 @@ func (s *server) AddPermissionlessValidator(
 
 	// check that the given subnets exist
 	subnetsSet := set.Set[string]{}
-	subnetsSet.Add(maps.Keys(s.clusterInfo.Subnets)...)
+	subnetsSet.Add(maps.Keys(ns.clusterInfo.Subnets)...)
 
 	for _, validatorSpec := range validatorSpecList {
 		if validatorSpec.SubnetID == "" {
@@ -560,14 +734,14 @@ func (s *server) AddPermissionlessValidator(
 		}
 	}
 
-	s.clusterInfo.Healthy = false
-	s.clusterInfo.CustomChainsHealthy = false
+	ns.clusterInfo.Healthy = false
+	ns.clusterInfo.CustomChainsHealthy = false
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	err := s.network.AddPermissionlessValidators(ctx, validatorSpecList)
+	err := ns.network.AddPermissionlessValidators(ctx, validatorSpecList)
 
-	s.updateClusterInfo()
+	s.updateClusterInfo(ns)
 
 	if err != nil {
 		s.log.Error("failed to add permissionless validator", zap.Error(err))
@@ -576,21 +750,130 @@ func (s *server) AddPermissionlessValidator(
 
 	s.log.Info("successfully added permissionless validator")
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
 	return &rpcpb.AddPermissionlessValidatorResponse{ClusterInfo: clusterInfo}, nil
 }
 
+func (s *server) AddPermissionlessDelegator(
+	_ context.Context,
+	req *rpcpb.AddPermissionlessDelegatorRequest,
+) (*rpcpb.AddPermissionlessDelegatorResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	s.log.Debug("AddPermissionlessDelegator")
+
+	if len(req.GetDelegatorSpec()) == 0 {
+		return nil, ErrNoDelegatorSpec
+	}
+
+	delegatorSpecList := []network.PermissionlessDelegatorSpec{}
+	for _, spec := range req.GetDelegatorSpec() {
+		delegatorSpec, err := getPermissionlessDelegatorSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		delegatorSpecList = append(delegatorSpecList, delegatorSpec)
+	}
+
+	// check that the given subnets exist
+	subnetsSet := set.Set[string]{}
+	subnetsSet.Add(maps.Keys(ns.clusterInfo.Subnets)...)
+
+	for _, delegatorSpec := range delegatorSpecList {
+		if delegatorSpec.SubnetID == "" {
+			return nil, ErrNoSubnetID
+		} else if !subnetsSet.Contains(delegatorSpec.SubnetID) {
+			return nil, fmt.Errorf("subnet id %q does not exist", delegatorSpec.SubnetID)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	txIDs, err := ns.network.AddPermissionlessDelegators(ctx, delegatorSpecList)
+	if err != nil {
+		s.log.Error("failed to add permissionless delegator", zap.Error(err))
+		return nil, err
+	}
+
+	s.log.Info("successfully added permissionless delegator")
+
+	strTxIDs := make([]string, len(txIDs))
+	for i, txID := range txIDs {
+		strTxIDs[i] = txID.String()
+	}
+	clusterInfo, err := deepCopy(ns.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AddPermissionlessDelegatorResponse{ClusterInfo: clusterInfo, TxIds: strTxIDs}, nil
+}
+
+func (s *server) AddPrimaryDelegator(
+	_ context.Context,
+	req *rpcpb.AddPrimaryDelegatorRequest,
+) (*rpcpb.AddPrimaryDelegatorResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.network == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	s.log.Debug("AddPrimaryDelegator")
+
+	if len(req.GetDelegatorSpec()) == 0 {
+		return nil, ErrNoDelegatorSpec
+	}
+
+	delegatorSpecList := []network.PrimaryDelegatorSpec{}
+	for _, spec := range req.GetDelegatorSpec() {
+		delegatorSpec, err := getPrimaryDelegatorSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		delegatorSpecList = append(delegatorSpecList, delegatorSpec)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
+	defer cancel()
+	txIDs, err := ns.network.AddPrimaryDelegators(ctx, delegatorSpecList)
+	if err != nil {
+		s.log.Error("failed to add primary delegator", zap.Error(err))
+		return nil, err
+	}
+
+	s.log.Info("successfully added primary delegator")
+
+	strTxIDs := make([]string, len(txIDs))
+	for i, txID := range txIDs {
+		strTxIDs[i] = txID.String()
+	}
+	clusterInfo, err := deepCopy(ns.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AddPrimaryDelegatorResponse{ClusterInfo: clusterInfo, TxIds: strTxIDs}, nil
+}
+
 func (s *server) RemoveSubnetValidator(
 	_ context.Context,
 	req *rpcpb.RemoveSubnetValidatorRequest,
 ) (*rpcpb.RemoveSubnetValidatorResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -608,7 +891,7 @@ func (s *server) RemoveSubnetValidator(
 
 	// check that the given subnets exist
 	subnetsSet := set.Set[string]{}
-	subnetsSet.Add(maps.Keys(s.clusterInfo.Subnets)...)
+	subnetsSet.Add(maps.Keys(ns.clusterInfo.Subnets)...)
 
 	for _, validatorSpec := range validatorSpecList {
 		if validatorSpec.SubnetID == "" {
@@ -618,14 +901,14 @@ func (s *server) RemoveSubnetValidator(
 		}
 	}
 
-	s.clusterInfo.Healthy = false
-	s.clusterInfo.CustomChainsHealthy = false
+	ns.clusterInfo.Healthy = false
+	ns.clusterInfo.CustomChainsHealthy = false
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	err := s.network.RemoveSubnetValidator(ctx, validatorSpecList)
+	err := ns.network.RemoveSubnetValidator(ctx, validatorSpecList)
 
-	s.updateClusterInfo()
+	s.updateClusterInfo(ns)
 
 	if err != nil {
 		s.log.Error("failed to remove subnet validator", zap.Error(err))
@@ -634,7 +917,7 @@ func (s *server) RemoveSubnetValidator(
 
 	s.log.Info("successfully removed subnet validator")
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -645,10 +928,11 @@ func (s *server) TransformElasticSubnets(
 	_ context.Context,
 	req *rpcpb.TransformElasticSubnetsRequest,
 ) (*rpcpb.TransformElasticSubnetsResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -666,7 +950,7 @@ func (s *server) TransformElasticSubnets(
 
 	// check that the given subnets exist
 	subnetsSet := set.Set[string]{}
-	subnetsSet.Add(maps.Keys(s.clusterInfo.Subnets)...)
+	subnetsSet.Add(maps.Keys(ns.clusterInfo.Subnets)...)
 
 	for _, elasticSubnetSpec := range elasticSubnetSpecList {
 		if elasticSubnetSpec.SubnetID == nil {
@@ -676,14 +960,14 @@ func (s *server) TransformElasticSubnets(
 		}
 	}
 
-	s.clusterInfo.Healthy = false
-	s.clusterInfo.CustomChainsHealthy = false
+	ns.clusterInfo.Healthy = false
+	ns.clusterInfo.CustomChainsHealthy = false
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	txIDs, assetIDs, err := s.network.TransformSubnets(ctx, elasticSubnetSpecList)
+	txIDs, assetIDs, err := ns.network.TransformSubnets(ctx, elasticSubnetSpecList)
 
-	s.updateClusterInfo()
+	s.updateClusterInfo(ns)
 
 	if err != nil {
 		s.log.Error("failed to transform subnet into elastic subnet", zap.Error(err))
@@ -702,7 +986,7 @@ func (s *server) TransformElasticSubnets(
 		strAssetIDs = append(strAssetIDs, assetID.String())
 	}
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -710,10 +994,11 @@ func (s *server) TransformElasticSubnets(
 }
 
 func (s *server) CreateSubnets(_ context.Context, req *rpcpb.CreateSubnetsRequest) (*rpcpb.CreateSubnetsResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -727,18 +1012,18 @@ func (s *server) CreateSubnets(_ context.Context, req *rpcpb.CreateSubnetsReques
 
 	s.log.Info("waiting for local cluster readiness")
 
-	s.clusterInfo.Healthy = false
-	s.clusterInfo.CustomChainsHealthy = false
+	ns.clusterInfo.Healthy = false
+	ns.clusterInfo.CustomChainsHealthy = false
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	subnetIDs, err := s.network.CreateSubnets(ctx, subnetSpecs)
+	subnetIDs, err := ns.network.CreateSubnets(ctx, subnetSpecs)
 	if err != nil {
 		s.log.Error("failed to create subnets", zap.Error(err))
-		s.stopAndRemoveNetwork(err)
+		s.stopAndRemoveNetwork(ns, err)
 		return nil, err
 	} else {
-		s.updateClusterInfo()
+		s.updateClusterInfo(ns)
 	}
 	s.log.Info("subnets created")
 
@@ -747,52 +1032,54 @@ func (s *server) CreateSubnets(_ context.Context, req *rpcpb.CreateSubnetsReques
 		strSubnetIDs = append(strSubnetIDs, subnetID.String())
 	}
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
 	return &rpcpb.CreateSubnetsResponse{ClusterInfo: clusterInfo, SubnetIds: strSubnetIDs}, nil
 }
 
-func (s *server) Health(ctx context.Context, _ *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *server) Health(ctx context.Context, req *rpcpb.HealthRequest) (*rpcpb.HealthResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("Health")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
 	s.log.Info("waiting for local cluster readiness")
-	if err := s.network.AwaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
+	if err := ns.network.AwaitHealthyAndUpdateNetworkInfo(ctx); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
-	s.clusterInfo.Healthy = true
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
+	ns.clusterInfo.Healthy = true
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
 	return &rpcpb.HealthResponse{ClusterInfo: clusterInfo}, nil
 }
 
-func (s *server) URIs(context.Context, *rpcpb.URIsRequest) (*rpcpb.URIsResponse, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *server) URIs(_ context.Context, req *rpcpb.URIsRequest) (*rpcpb.URIsResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 
 	s.log.Debug("URIs")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	uris := make([]string, 0, len(s.clusterInfo.NodeInfos))
-	for _, nodeInfo := range s.clusterInfo.NodeInfos {
+	uris := make([]string, 0, len(ns.clusterInfo.NodeInfos))
+	for _, nodeInfo := range ns.clusterInfo.NodeInfos {
 		uris = append(uris, nodeInfo.Uri)
 	}
 	sort.Strings(uris)
@@ -800,41 +1087,61 @@ func (s *server) URIs(context.Context, *rpcpb.URIsRequest) (*rpcpb.URIsResponse,
 	return &rpcpb.URIsResponse{Uris: uris}, nil
 }
 
-func (s *server) Status(context.Context, *rpcpb.StatusRequest) (*rpcpb.StatusResponse, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *server) Status(_ context.Context, req *rpcpb.StatusRequest) (*rpcpb.StatusResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 
 	s.log.Debug("Status")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return &rpcpb.StatusResponse{}, ErrNotBootstrapped
 	}
 
-	return &rpcpb.StatusResponse{ClusterInfo: s.clusterInfo}, nil
+	clusterInfo, err := deepCopy(ns.clusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	// node processes may have been relaunched by their restart policy, so the
+	// response gets fresh node infos, without changing the network state
+	if nodeInfos, err := ns.network.GetNodeInfos(); err != nil {
+		s.log.Debug("couldn't get node infos", zap.Error(err))
+	} else {
+		clusterInfo.NodeNames = maps.Keys(nodeInfos)
+		sort.Strings(clusterInfo.NodeNames)
+		clusterInfo.NodeInfos = nodeInfos
+	}
+
+	return &rpcpb.StatusResponse{ClusterInfo: clusterInfo}, nil
 }
 
-// Assumes [s.mu] is held.
-func (s *server) stopAndRemoveNetwork(err error) {
-	s.log.Info("removing network")
+// Assumes [ns.mu] is held.
+func (s *server) stopAndRemoveNetwork(ns *networkState, err error) {
+	s.log.Info("removing network", zap.String("network-name", ns.name))
 	select {
 	// cleanup of possible previous unchecked async err
-	case err := <-s.asyncErrCh:
+	case err := <-ns.asyncErrCh:
 		s.log.Debug(fmt.Sprintf("async err %s not returned to user", err))
 	default:
 	}
 	if err != nil {
-		s.asyncErrCh <- err
+		ns.asyncErrCh <- err
 	}
-	if s.network != nil {
+	if ns.chaos != nil {
+		// can't wait for it, as it needs [ns.mu] to finish
+		ns.chaos.Cancel()
+	}
+	if ns.network != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
 		defer cancel()
-		s.network.Stop(ctx)
+		ns.network.Stop(ctx)
+		ns.network.publishEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NETWORK_STOPPED})
 	}
-	if s.clusterInfo != nil {
-		s.clusterInfo.Healthy = false
-		s.clusterInfo.CustomChainsHealthy = false
+	if ns.clusterInfo != nil {
+		ns.clusterInfo.Healthy = false
+		ns.clusterInfo.CustomChainsHealthy = false
 	}
-	s.network = nil
+	ns.network = nil
 }
 
 // TODO document this
@@ -847,8 +1154,9 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 	s.log.Info("pushing status updates to the stream", zap.String("interval", interval.String()))
 	wg := sync.WaitGroup{}
 	wg.Add(1)
+	ns := s.getNetworkState(req.GetNetworkName())
 	go func() {
-		s.sendLoop(stream, interval)
+		s.sendLoop(ns, stream, interval)
 		wg.Done()
 	}()
 
@@ -882,7 +1190,7 @@ func (s *server) StreamStatus(req *rpcpb.StreamStatusRequest, stream rpcpb.Contr
 }
 
 // TODO document this
-func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interval time.Duration) {
+func (s *server) sendLoop(ns *networkState, stream rpcpb.ControlService_StreamStatusServer, interval time.Duration) {
 	s.log.Info("start status send loop")
 
 	tc := time.NewTicker(1)
@@ -898,9 +1206,9 @@ func (s *server) sendLoop(stream rpcpb.ControlService_StreamStatusServer, interv
 
 		s.log.Debug("sending cluster info")
 
-		s.mu.RLock()
-		err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: s.clusterInfo})
-		s.mu.RUnlock()
+		ns.mu.RLock()
+		err := stream.Send(&rpcpb.StreamStatusResponse{ClusterInfo: ns.clusterInfo})
+		ns.mu.RUnlock()
 		if err != nil {
 			if isClientCanceled(stream.Context().Err(), err) {
 				s.log.Debug("client stream canceled", zap.Error(err))
@@ -937,12 +1245,13 @@ func (s *server) recvLoop(stream rpcpb.ControlService_StreamStatusServer) error
 }
 
 func (s *server) AddNode(_ context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.AddNodeResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
-	s.log.Debug("AddNode", zap.String("name", req.Name))
+	s.log.Debug("AddNode", zap.String("name", req.Name), zap.String("clone-db-from", req.CloneDbFrom))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
@@ -966,21 +1275,25 @@ func (s *server) AddNode(_ context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.A
 		ChainConfigFiles:   req.ChainConfigs,
 		UpgradeConfigFiles: req.UpgradeConfigs,
 		SubnetConfigFiles:  req.SubnetConfigs,
+		RestartPolicy:      getRestartPolicy(req.GetRestartPolicy()),
+		CloneDBFrom:        req.CloneDbFrom,
 	}
 
-	if _, err := s.network.nw.AddNode(nodeConfig); err != nil {
+	newNode, err := ns.network.nw.AddNode(nodeConfig)
+	if err != nil {
 		return nil, err
 	}
+	ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_STARTED, NodeName: newNode.GetName()})
 
-	if err := s.network.UpdateNodeInfo(); err != nil {
+	if err := ns.network.UpdateNodeInfo(); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -988,28 +1301,30 @@ func (s *server) AddNode(_ context.Context, req *rpcpb.AddNodeRequest) (*rpcpb.A
 }
 
 func (s *server) RemoveNode(ctx context.Context, req *rpcpb.RemoveNodeRequest) (*rpcpb.RemoveNodeResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("RemoveNode", zap.String("name", req.Name))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.RemoveNode(ctx, req.Name); err != nil {
+	if err := ns.network.nw.RemoveNode(ctx, req.Name); err != nil {
 		return nil, err
 	}
+	ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_REMOVED, NodeName: req.Name})
 
-	if err := s.network.UpdateNodeInfo(); err != nil {
+	if err := ns.network.UpdateNodeInfo(); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -1017,16 +1332,17 @@ func (s *server) RemoveNode(ctx context.Context, req *rpcpb.RemoveNodeRequest) (
 }
 
 func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest) (*rpcpb.RestartNodeResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("RestartNode", zap.String("name", req.Name))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.RestartNode(
+	if err := ns.network.nw.RestartNode(
 		ctx,
 		req.Name,
 		req.GetExecPath(),
@@ -1038,16 +1354,17 @@ func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest)
 	); err != nil {
 		return nil, err
 	}
+	ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_RESTARTED, NodeName: req.Name})
 
-	if err := s.network.UpdateNodeInfo(); err != nil {
+	if err := ns.network.UpdateNodeInfo(); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -1055,70 +1372,75 @@ func (s *server) RestartNode(ctx context.Context, req *rpcpb.RestartNodeRequest)
 }
 
 func (s *server) PauseNode(ctx context.Context, req *rpcpb.PauseNodeRequest) (*rpcpb.PauseNodeResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("PauseNode", zap.String("name", req.Name))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.PauseNode(
+	if err := ns.network.nw.PauseNode(
 		ctx,
 		req.Name,
 	); err != nil {
 		return nil, err
 	}
+	ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_PAUSED, NodeName: req.Name})
 
-	if err := s.network.UpdateNodeInfo(); err != nil {
+	if err := ns.network.UpdateNodeInfo(); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
 
-	return &rpcpb.PauseNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.PauseNodeResponse{ClusterInfo: ns.clusterInfo}, nil
 }
 
 func (s *server) ResumeNode(ctx context.Context, req *rpcpb.ResumeNodeRequest) (*rpcpb.ResumeNodeResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("ResumeNode", zap.String("name", req.Name))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.ResumeNode(
+	if err := ns.network.nw.ResumeNode(
 		ctx,
 		req.Name,
 	); err != nil {
 		return nil, err
 	}
+	ns.network.publishNodeEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_NODE_RESUMED, NodeName: req.Name})
 
-	if err := s.network.UpdateNodeInfo(); err != nil {
+	if err := ns.network.UpdateNodeInfo(); err != nil {
 		return nil, err
 	}
 
-	s.clusterInfo.NodeNames = maps.Keys(s.network.nodeInfos)
-	sort.Strings(s.clusterInfo.NodeNames)
-	s.clusterInfo.NodeInfos = s.network.nodeInfos
+	ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+	sort.Strings(ns.clusterInfo.NodeNames)
+	ns.clusterInfo.NodeInfos = ns.network.nodeInfos
 
-	return &rpcpb.ResumeNodeResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.ResumeNodeResponse{ClusterInfo: ns.clusterInfo}, nil
 }
 
-func (s *server) Stop(context.Context, *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *server) Stop(_ context.Context, req *rpcpb.StopRequest) (*rpcpb.StopResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer s.unlockNetworkState(ns)
 
 	s.log.Debug("Stop")
 
-	s.stopAndRemoveNetwork(nil)
+	s.stopAndRemoveNetwork(ns, nil)
 
-	return &rpcpb.StopResponse{ClusterInfo: s.clusterInfo}, nil
+	return &rpcpb.StopResponse{ClusterInfo: ns.clusterInfo}, nil
 }
 
 var _ router.InboundHandler = &loggingInboundHandler{}
@@ -1137,16 +1459,17 @@ func (lh *loggingInboundHandler) HandleInbound(_ context.Context, m message.Inbo
 }
 
 func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (*rpcpb.AttachPeerResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("AttachPeer")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	node, err := s.network.nw.GetNode(req.NodeName)
+	node, err := ns.network.nw.GetNode(req.NodeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1160,19 +1483,19 @@ func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (
 	newPeerID := newPeer.ID().String()
 	s.log.Debug("new peer is attached to", zap.String("peer-ID", newPeerID), zap.String("node-name", node.GetName()))
 
-	if s.clusterInfo.AttachedPeerInfos == nil {
-		s.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
+	if ns.clusterInfo.AttachedPeerInfos == nil {
+		ns.clusterInfo.AttachedPeerInfos = make(map[string]*rpcpb.ListOfAttachedPeerInfo)
 	}
 	peerInfo := &rpcpb.AttachedPeerInfo{Id: newPeerID}
-	if v, ok := s.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
+	if v, ok := ns.clusterInfo.AttachedPeerInfos[req.NodeName]; ok {
 		v.Peers = append(v.Peers, peerInfo)
 	} else {
-		s.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
+		ns.clusterInfo.AttachedPeerInfos[req.NodeName] = &rpcpb.ListOfAttachedPeerInfo{
 			Peers: []*rpcpb.AttachedPeerInfo{peerInfo},
 		}
 	}
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -1180,16 +1503,17 @@ func (s *server) AttachPeer(ctx context.Context, req *rpcpb.AttachPeerRequest) (
 }
 
 func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboundMessageRequest) (*rpcpb.SendOutboundMessageResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Debug("SendOutboundMessage")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	node, err := s.network.nw.GetNode(req.NodeName)
+	node, err := ns.network.nw.GetNode(req.NodeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1199,34 +1523,32 @@ func (s *server) SendOutboundMessage(ctx context.Context, req *rpcpb.SendOutboun
 }
 
 func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest) (*rpcpb.LoadSnapshotResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns, err := s.getOrCreateNetworkState(req.GetNetworkName())
+	if err != nil {
+		return nil, err
+	}
+	defer s.unlockNetworkState(ns)
 
-	s.log.Debug("LoadSnapshot")
+	s.log.Debug("LoadSnapshot",
+		zap.Strings("drop-nodes", req.DropNodes),
+		zap.Strings("paused-nodes", req.PausedNodes),
+		zap.Int("extra-nodes", len(req.ExtraNodeConfigs)),
+	)
 
-	if s.network != nil {
+	if ns.network != nil {
 		return nil, ErrAlreadyBootstrapped
 	}
 
-	var err error
 	rootDataDir := req.GetRootDataDir()
-	if len(rootDataDir) == 0 {
-		rootDataDir = filepath.Join(os.TempDir(), constants.RootDirPrefix)
-		err = os.MkdirAll(rootDataDir, os.ModePerm)
-		if err != nil {
-			return nil, err
-		}
-	}
-	rootDataDir = filepath.Join(rootDataDir, networkRootDirPrefix)
-	rootDataDir, err = utils.MkDirWithTimestamp(rootDataDir)
+	rootDataDir, err = local.NewNetworkRootDir(rootDataDir, getNetworkRootDirPrefix(ns.name))
 	if err != nil {
 		return nil, err
 	}
 
 	pid := int32(os.Getpid())
-	s.log.Info("starting", zap.Int32("pid", pid), zap.String("root-data-dir", rootDataDir))
+	s.log.Info("starting", zap.String("network-name", ns.name), zap.Int32("pid", pid), zap.String("root-data-dir", rootDataDir))
 
-	s.network, err = newLocalNetwork(localNetworkOptions{
+	ns.network, err = newLocalNetwork(localNetworkOptions{
 		execPath:            req.GetExecPath(),
 		pluginDir:           req.GetPluginDir(),
 		rootDataDir:         rootDataDir,
@@ -1237,34 +1559,43 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 		logLevel:            s.cfg.LogLevel,
 		reassignPortsIfUsed: req.GetReassignPortsIfUsed(),
 		snapshotsDir:        s.cfg.SnapshotsDir,
+		snapshotStore:       s.snapshotStore,
+		dropNodes:           req.DropNodes,
+		pausedNodes:         req.PausedNodes,
+		extraNodeConfigs:    req.ExtraNodeConfigs,
+		allowIncompatible:   req.AllowIncompatible,
+		networkName:         ns.name,
+		events:              s.events,
+		metrics:             s.metrics,
 	})
 	if err != nil {
 		return nil, err
 	}
-	s.clusterInfo = &rpcpb.ClusterInfo{
+	ns.clusterInfo = &rpcpb.ClusterInfo{
 		Pid:         pid,
 		RootDataDir: rootDataDir,
+		NetworkName: ns.name,
 	}
 
 	// blocking load snapshot to soon get not found snapshot errors
-	if err := s.network.LoadSnapshot(req.SnapshotName); err != nil {
+	if err := ns.network.LoadSnapshot(req.SnapshotName); err != nil {
 		s.log.Warn("snapshot load failed to complete", zap.Error(err))
-		s.stopAndRemoveNetwork(nil)
+		s.stopAndRemoveNetwork(ns, nil)
 		return nil, err
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), waitForHealthyTimeout)
 	defer cancel()
-	err = s.network.AwaitHealthyAndUpdateNetworkInfo(ctx)
+	err = ns.network.AwaitHealthyAndUpdateNetworkInfo(ctx)
 	if err != nil {
 		s.log.Warn("snapshot load failed to complete. stopping network and cleaning up network", zap.Error(err))
-		s.stopAndRemoveNetwork(err)
+		s.stopAndRemoveNetwork(ns, err)
 		return nil, err
 	}
-	s.updateClusterInfo()
+	s.updateClusterInfo(ns)
 	s.log.Info("network healthy")
 
-	clusterInfo, err := deepCopy(s.clusterInfo)
+	clusterInfo, err := deepCopy(ns.clusterInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -1272,60 +1603,119 @@ func (s *server) LoadSnapshot(_ context.Context, req *rpcpb.LoadSnapshotRequest)
 }
 
 func (s *server) SaveSnapshot(ctx context.Context, req *rpcpb.SaveSnapshotRequest) (*rpcpb.SaveSnapshotResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer s.unlockNetworkState(ns)
 
-	s.log.Info("SaveSnapshot", zap.String("snapshot-name", req.SnapshotName))
+	s.log.Info("SaveSnapshot", zap.String("snapshot-name", req.SnapshotName), zap.Bool("keep-running", req.KeepRunning))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	snapshotPath, err := s.network.nw.SaveSnapshot(ctx, req.SnapshotName)
+	if req.KeepRunning {
+		// the server network info, custom chains included, is kept as is
+		snapshotPath, err := ns.network.nw.SaveSnapshotAndContinue(ctx, req.SnapshotName, req.Description, req.Labels)
+		if err != nil {
+			s.log.Warn("snapshot save failed to complete", zap.Error(err))
+			return nil, err
+		}
+		ns.network.publishEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_SNAPSHOT_SAVED, SnapshotName: req.SnapshotName})
+		s.applySnapshotRetention(ctx)
+
+		if err := ns.network.UpdateNodeInfo(); err != nil {
+			return nil, err
+		}
+
+		ns.clusterInfo.NodeNames = maps.Keys(ns.network.nodeInfos)
+		sort.Strings(ns.clusterInfo.NodeNames)
+		ns.clusterInfo.NodeInfos = ns.network.nodeInfos
+
+		clusterInfo, err := deepCopy(ns.clusterInfo)
+		if err != nil {
+			return nil, err
+		}
+		return &rpcpb.SaveSnapshotResponse{SnapshotPath: snapshotPath, ClusterInfo: clusterInfo}, nil
+	}
+
+	snapshotPath, err := ns.network.nw.SaveSnapshot(ctx, req.SnapshotName, req.Description, req.Labels)
 	if err != nil {
 		s.log.Warn("snapshot save failed to complete", zap.Error(err))
 		return nil, err
 	}
+	ns.network.publishEvent(&rpcpb.Event{Type: rpcpb.EventType_EVENT_TYPE_SNAPSHOT_SAVED, SnapshotName: req.SnapshotName})
+	s.applySnapshotRetention(ctx)
 
-	s.stopAndRemoveNetwork(nil)
+	s.stopAndRemoveNetwork(ns, nil)
 
 	return &rpcpb.SaveSnapshotResponse{SnapshotPath: snapshotPath}, nil
 }
 
 func (s *server) RemoveSnapshot(_ context.Context, req *rpcpb.RemoveSnapshotRequest) (*rpcpb.RemoveSnapshotResponse, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
 
 	s.log.Info("RemoveSnapshot", zap.String("snapshot-name", req.SnapshotName))
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	if err := s.network.nw.RemoveSnapshot(req.SnapshotName); err != nil {
+	if err := ns.network.nw.RemoveSnapshot(req.SnapshotName); err != nil {
 		s.log.Warn("snapshot remove failed to complete", zap.Error(err))
 		return nil, err
 	}
 	return &rpcpb.RemoveSnapshotResponse{}, nil
 }
 
-func (s *server) GetSnapshotNames(context.Context, *rpcpb.GetSnapshotNamesRequest) (*rpcpb.GetSnapshotNamesResponse, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *server) GetSnapshotNames(_ context.Context, req *rpcpb.GetSnapshotNamesRequest) (*rpcpb.GetSnapshotNamesResponse, error) {
+	ns := s.getNetworkState(req.GetNetworkName())
+	ns.mu.RLock()
+	defer ns.mu.RUnlock()
 
 	s.log.Info("GetSnapshotNames")
 
-	if s.network == nil {
+	if ns.network == nil {
 		return nil, ErrNotBootstrapped
 	}
 
-	snapshotNames, err := s.network.nw.GetSnapshotNames()
+	snapshotNames, err := ns.network.nw.GetSnapshotNames()
 	if err != nil {
 		return nil, err
 	}
 	return &rpcpb.GetSnapshotNamesResponse{SnapshotNames: snapshotNames}, nil
 }
 
+func (s *server) ListNetworks(context.Context, *rpcpb.ListNetworksRequest) (*rpcpb.ListNetworksResponse, error) {
+	s.log.Debug("ListNetworks")
+
+	// do not hold [s.mu] while waiting on each network lock
+	s.mu.RLock()
+	networks := maps.Clone(s.networks)
+	s.mu.RUnlock()
+
+	networkNames := []string{}
+	clusterInfos := map[string]*rpcpb.ClusterInfo{}
+	for networkName, ns := range networks {
+		ns.mu.RLock()
+		if ns.network == nil {
+			ns.mu.RUnlock()
+			continue
+		}
+		clusterInfo, err := deepCopy(ns.clusterInfo)
+		ns.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		networkNames = append(networkNames, networkName)
+		clusterInfos[networkName] = clusterInfo
+	}
+	sort.Strings(networkNames)
+
+	return &rpcpb.ListNetworksResponse{NetworkNames: networkNames, ClusterInfos: clusterInfos}, nil
+}
+
 func isClientCanceled(ctxErr error, err error) bool {
 	if ctxErr != nil {
 		return true
@@ -1416,6 +1806,54 @@ func getPermissionlessValidatorSpec(
 	return validatorSpec, nil
 }
 
+// Parses [startTime], if not empty, and checks it leaves enough time to issue
+// the staking tx of [nodeName].
+func getStakingStartTime(startTime string, nodeName string) (time.Time, error) {
+	if startTime == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(TimeParseLayout, startTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if t.Before(time.Now().Add(StakingMinimumLeadTime)) {
+		return time.Time{}, fmt.Errorf("time should be at least %s in the future for delegator spec of %s", StakingMinimumLeadTime, nodeName)
+	}
+	return t, nil
+}
+
+func getPermissionlessDelegatorSpec(
+	spec *rpcpb.PermissionlessDelegatorSpec,
+) (network.PermissionlessDelegatorSpec, error) {
+	startTime, err := getStakingStartTime(spec.StartTime, spec.NodeName)
+	if err != nil {
+		return network.PermissionlessDelegatorSpec{}, err
+	}
+	return network.PermissionlessDelegatorSpec{
+		SubnetID:      spec.SubnetId,
+		AssetID:       spec.AssetId,
+		NodeName:      spec.NodeName,
+		StakedAmount:  spec.StakedTokenAmount,
+		StartTime:     startTime,
+		StakeDuration: time.Duration(spec.StakeDuration) * time.Hour,
+	}, nil
+}
+
+func getPrimaryDelegatorSpec(
+	spec *rpcpb.PrimaryDelegatorSpec,
+) (network.PrimaryDelegatorSpec, error) {
+	startTime, err := getStakingStartTime(spec.StartTime, spec.NodeName)
+	if err != nil {
+		return network.PrimaryDelegatorSpec{}, err
+	}
+	return network.PrimaryDelegatorSpec{
+		NodeName:      spec.NodeName,
+		StakedAmount:  spec.StakedTokenAmount,
+		StartTime:     startTime,
+		StakeDuration: time.Duration(spec.StakeDuration) * time.Hour,
+	}, nil
+}
+
 func getRemoveSubnetValidatorSpec(
 	spec *rpcpb.RemoveSubnetValidatorSpec,
 ) network.RemoveSubnetValidatorSpec {
@@ -1522,6 +1960,16 @@ func getNetworkSubnetSpec(
 	}
 }
 
+// converts [policy] to the node restart policy, with its delays in milliseconds
+func getRestartPolicy(policy *rpcpb.RestartPolicy) node.RestartPolicy {
+	return node.RestartPolicy{
+		Type:       node.RestartPolicyType(policy.GetType()),
+		MaxRetries: policy.GetMaxRetries(),
+		Backoff:    time.Duration(policy.GetBackoffMs()) * time.Millisecond,
+		MaxBackoff: time.Duration(policy.GetMaxBackoffMs()) * time.Millisecond,
+	}
+}
+
 // if [conf] is a readable file path, returns the file contents
 // if not, returns [conf] as a byte slice
 func readFileOrString(conf string) []byte {
@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkStateRemoval(t *testing.T) {
+	require := require.New(t)
+
+	s := &server{
+		mu:       new(sync.RWMutex),
+		log:      logging.NoLog{},
+		networks: map[string]*networkState{},
+	}
+
+	// a network that fails to start is not kept
+	_, err := s.Start(context.Background(), &rpcpb.StartRequest{NetworkName: "net1", ExecPath: "/missing/odysseygo"})
+	require.Error(err)
+	require.Empty(s.networks)
+
+	// a stopped network is not kept
+	ns := newTestNetworkState("net1", map[string]*rpcpb.NodeInfo{})
+	s.networks["net1"] = ns
+	resp, err := s.Stop(context.Background(), &rpcpb.StopRequest{NetworkName: "net1"})
+	require.NoError(err)
+	require.Equal("net1", resp.ClusterInfo.NetworkName)
+	require.Empty(s.networks)
+	require.True(ns.removed)
+
+	// stopping a missing network doesn't register it
+	_, err = s.Stop(context.Background(), &rpcpb.StopRequest{NetworkName: "net2"})
+	require.NoError(err)
+	require.Empty(s.networks)
+
+	// a removed state is not reused
+	newNS, err := s.getOrCreateNetworkState("net1")
+	require.NoError(err)
+	require.NotSame(ns, newNS)
+	// a started one is kept
+	newNS.network = newTestNetworkState("net1", nil).network
+	s.unlockNetworkState(newNS)
+	require.Same(newNS, s.networks["net1"])
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"errors"
+	"io"
+
+	"github.com/DioneProtocol/odyssey-network-runner/local"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+// Max size of the archive chunks sent on export
+const snapshotArchiveChunkSize = 1024 * 1024
+
+func (s *server) ExportSnapshot(req *rpcpb.ExportSnapshotRequest, stream rpcpb.ControlService_ExportSnapshotServer) error {
+	s.log.Debug("ExportSnapshot", zap.String("snapshot-name", req.SnapshotName))
+
+	w := &exportSnapshotWriter{stream: stream}
+	manifest, err := local.ExportStoredSnapshot(stream.Context(), s.snapshotStore, s.cfg.SnapshotsDir, req.SnapshotName, s.cfg.RunnerVersion, w)
+	if err != nil {
+		s.log.Warn("snapshot export failed", zap.String("snapshot-name", req.SnapshotName), zap.Error(err))
+		return err
+	}
+	s.log.Info("snapshot exported",
+		zap.String("snapshot-name", req.SnapshotName),
+		zap.Int("num-files", len(manifest.Files)),
+	)
+	return nil
+}
+
+func (s *server) ImportSnapshot(stream rpcpb.ControlService_ImportSnapshotServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	s.log.Debug("ImportSnapshot", zap.String("snapshot-name", req.SnapshotName))
+
+	r := &importSnapshotReader{stream: stream, chunk: req.Chunk}
+	manifest, err := local.ImportStoredSnapshot(stream.Context(), s.snapshotStore, s.cfg.SnapshotsDir, req.SnapshotName, r)
+	if err != nil {
+		s.log.Warn("snapshot import failed", zap.String("snapshot-name", req.SnapshotName), zap.Error(err))
+		return err
+	}
+	s.log.Info("snapshot imported",
+		zap.String("snapshot-name", manifest.SnapshotName),
+		zap.String("odysseygo-version", manifest.OdysseyGoVersion),
+		zap.String("runner-version", manifest.RunnerVersion),
+	)
+	s.applySnapshotRetention(stream.Context())
+	return stream.SendAndClose(&rpcpb.ImportSnapshotResponse{
+		SnapshotName:     manifest.SnapshotName,
+		OdysseygoVersion: manifest.OdysseyGoVersion,
+		RunnerVersion:    manifest.RunnerVersion,
+		NumFiles:         uint32(len(manifest.Files)),
+	})
+}
+
+// exportSnapshotWriter sends the archive written to it as stream chunks.
+type exportSnapshotWriter struct {
+	stream rpcpb.ControlService_ExportSnapshotServer
+}
+
+func (w *exportSnapshotWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > snapshotArchiveChunkSize {
+			chunk = chunk[:snapshotArchiveChunkSize]
+		}
+		if err := w.stream.Send(&rpcpb.ExportSnapshotResponse{Chunk: chunk}); err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// importSnapshotReader reads the archive from the stream chunks.
+type importSnapshotReader struct {
+	stream rpcpb.ControlService_ImportSnapshotServer
+	// Unread part of the last received chunk
+	chunk []byte
+}
+
+func (r *importSnapshotReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.Chunk
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odyssey-network-runner/local"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) GetSnapshotInfo(ctx context.Context, req *rpcpb.GetSnapshotInfoRequest) (*rpcpb.GetSnapshotInfoResponse, error) {
+	s.log.Debug("GetSnapshotInfo", zap.String("snapshot-name", req.SnapshotName))
+
+	metadata, err := s.snapshotStore.GetMetadata(ctx, req.SnapshotName)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetSnapshotInfoResponse{SnapshotInfo: getRPCSnapshotInfo(metadata)}, nil
+}
+
+func (s *server) ListSnapshots(ctx context.Context, req *rpcpb.ListSnapshotsRequest) (*rpcpb.ListSnapshotsResponse, error) {
+	s.log.Debug("ListSnapshots", zap.Any("labels", req.Labels))
+
+	snapshotsMetadata, err := local.ListSnapshotMetadata(ctx, s.snapshotStore, req.Labels)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.ListSnapshotsResponse{Snapshots: []*rpcpb.SnapshotInfo{}}
+	for _, metadata := range snapshotsMetadata {
+		resp.Snapshots = append(resp.Snapshots, getRPCSnapshotInfo(metadata))
+	}
+	return resp, nil
+}
+
+func getRPCSnapshotInfo(metadata *local.SnapshotMetadata) *rpcpb.SnapshotInfo {
+	info := &rpcpb.SnapshotInfo{
+		SnapshotName:     metadata.SnapshotName,
+		CreatedAt:        metadata.CreatedAt.UnixMilli(),
+		Description:      metadata.Description,
+		Labels:           metadata.Labels,
+		OdysseygoVersion: metadata.OdysseyGoVersion,
+		NumNodes:         uint32(metadata.NumNodes),
+		DbSize:           uint64(metadata.DBSize),
+		DatabaseVersion:  metadata.DatabaseVersion,
+		VmVersions:       metadata.VMVersions,
+		FormatVersion:    metadata.FormatVersion,
+	}
+	for _, subnet := range metadata.Subnets {
+		subnetInfo := &rpcpb.SnapshotSubnetInfo{
+			SubnetId:        subnet.ID,
+			ElasticSubnetId: subnet.ElasticSubnetID,
+		}
+		for _, blockchain := range subnet.Blockchains {
+			subnetInfo.Blockchains = append(subnetInfo.Blockchains, &rpcpb.SnapshotBlockchainInfo{
+				BlockchainId: blockchain.ID,
+				ChainName:    blockchain.Name,
+				VmId:         blockchain.VMID,
+				VmName:       blockchain.VMName,
+			})
+		}
+		info.Subnets = append(info.Subnets, subnetInfo)
+	}
+	return info
+}
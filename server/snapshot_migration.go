@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2022, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odyssey-network-runner/local"
+	"github.com/DioneProtocol/odyssey-network-runner/rpcpb"
+	"go.uber.org/zap"
+)
+
+func (s *server) MigrateSnapshot(ctx context.Context, req *rpcpb.MigrateSnapshotRequest) (*rpcpb.MigrateSnapshotResponse, error) {
+	s.log.Info("MigrateSnapshot", zap.String("snapshot-name", req.SnapshotName), zap.Bool("dry-run", req.DryRun))
+
+	migration, err := local.MigrateStoredSnapshot(ctx, s.snapshotStore, s.cfg.SnapshotsDir, req.SnapshotName, req.DryRun)
+	if err != nil {
+		s.log.Warn("snapshot migration failed", zap.String("snapshot-name", req.SnapshotName), zap.Error(err))
+		return nil, err
+	}
+	if !req.DryRun && len(migration.Applied) > 0 {
+		s.log.Info("snapshot migrated",
+			zap.String("snapshot-name", req.SnapshotName),
+			zap.Uint32("from-version", migration.FromVersion),
+			zap.Strings("migrations", migration.Applied),
+		)
+	}
+	return &rpcpb.MigrateSnapshotResponse{
+		FromVersion:       migration.FromVersion,
+		ToVersion:         migration.ToVersion,
+		AppliedMigrations: migration.Applied,
+	}, nil
+}
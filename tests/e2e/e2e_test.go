@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/DioneProtocol/odysseygo/api/admin"
+	"github.com/DioneProtocol/odysseygo/genesis"
 	"github.com/DioneProtocol/odysseygo/message"
 	odygo_constants "github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
@@ -29,6 +30,7 @@ import (
 	"github.com/DioneProtocol/odyssey-network-runner/ux"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/units"
 	ginkgo "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 )
@@ -1015,6 +1017,108 @@ var _ = ginkgo.Describe("[Start/Remove/Restart/Add/Stop]", func() {
 		})
 	})
 
+	ginkgo.It("can add delegators", func() {
+		ginkgo.By("adding a permissionless delegator to elastic subnet", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			resp, err := cli.AddPermissionlessDelegator(ctx, []*rpcpb.PermissionlessDelegatorSpec{{
+				SubnetId:          createdSubnetID,
+				AssetId:           elasticAssetID,
+				NodeName:          "permissionlessNode",
+				StakedTokenAmount: testElasticSubnetConfig.MinDelegatorStake,
+			}})
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(len(resp.TxIds)).Should(gomega.Equal(1))
+		})
+		ginkgo.By("adding a permissionless delegator below the minimum stake fails", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			_, err := cli.AddPermissionlessDelegator(ctx, []*rpcpb.PermissionlessDelegatorSpec{{
+				SubnetId:          createdSubnetID,
+				NodeName:          "permissionlessNode",
+				StakedTokenAmount: testElasticSubnetConfig.MinDelegatorStake - 1,
+			}})
+			gomega.Ω(err).ShouldNot(gomega.BeNil())
+		})
+		ginkgo.By("adding a primary delegator", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			resp, err := cli.AddPrimaryDelegator(ctx, []*rpcpb.PrimaryDelegatorSpec{{
+				NodeName:          "permissionlessNode",
+				StakedTokenAmount: genesis.LocalParams.MinDelegatorStake,
+				StakeDuration:     14 * 24,
+			}})
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(len(resp.TxIds)).Should(gomega.Equal(1))
+		})
+		ginkgo.By("adding a primary delegator below the minimum duration fails", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			_, err := cli.AddPrimaryDelegator(ctx, []*rpcpb.PrimaryDelegatorSpec{{
+				NodeName:          "permissionlessNode",
+				StakedTokenAmount: genesis.LocalParams.MinDelegatorStake,
+				StakeDuration:     1,
+			}})
+			gomega.Ω(err).ShouldNot(gomega.BeNil())
+		})
+	})
+
+	ginkgo.It("can transfer between chains", func() {
+		var omegaCli omegavm.Client
+		ginkgo.By("get an O-chain client", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			clientURIs, err := cli.URIs(ctx)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(clientURIs).ShouldNot(gomega.BeEmpty())
+			omegaCli = omegavm.NewClient(clientURIs[0])
+		})
+		getOChainBalance := func() uint64 {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+			resp, err := omegaCli.GetBalance(ctx, []ids.ShortID{genesis.EWOQKey.Address()})
+			gomega.Ω(err).Should(gomega.BeNil())
+			return uint64(resp.Balance)
+		}
+		transfer := func(sourceChain string, destinationChain string, opts ...client.OpOption) {
+			ux.Print(log, logging.Green.Wrap("transferring from the %s-chain to the %s-chain"), sourceChain, destinationChain)
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			resp, err := cli.CrossChainTransfer(ctx, sourceChain, destinationChain, units.Dione, opts...)
+			cancel()
+			gomega.Ω(err).Should(gomega.BeNil())
+			gomega.Ω(resp.ExportTxId).ShouldNot(gomega.BeEmpty())
+			gomega.Ω(resp.ImportTxId).ShouldNot(gomega.BeEmpty())
+		}
+		ginkgo.By("transfer from the A-chain to the O-chain", func() {
+			balance := getOChainBalance()
+			transfer("A", "O")
+			gomega.Ω(getOChainBalance()).Should(gomega.BeNumerically(">", balance))
+		})
+		ginkgo.By("transfer from the O-chain to the A-chain", func() {
+			balance := getOChainBalance()
+			transfer("O", "A", client.WithPrivateKey(genesis.EWOQKey.String()))
+			gomega.Ω(getOChainBalance()).Should(gomega.BeNumerically("<", balance))
+		})
+		ginkgo.By("transfer from the A-chain to the D-chain", func() {
+			transfer("A", "D")
+		})
+		ginkgo.By("transfer from the D-chain to the A-chain", func() {
+			transfer("D", "A")
+		})
+		ginkgo.By("transfer fails between the same chain", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			_, err := cli.CrossChainTransfer(ctx, "A", "A", units.Dione)
+			cancel()
+			gomega.Ω(err).ShouldNot(gomega.BeNil())
+		})
+		ginkgo.By("transfer fails for a non-DIONE asset to the D-chain", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			_, err := cli.CrossChainTransfer(ctx, "A", "D", units.Dione, client.WithAssetID(elasticAssetID))
+			cancel()
+			gomega.Ω(err).ShouldNot(gomega.BeNil())
+		})
+	})
+
 	ginkgo.It("snapshots + blockchain creation", func() {
 		var originalUris []string
 		var originalSubnets []string
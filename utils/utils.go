@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -88,6 +89,21 @@ func VMID(vmName string) (ids.ID, error) {
 	return ids.ToID(b)
 }
 
+// VMName returns the VM name [vmID] was derived from with VMID, or an empty
+// string if it was not derived from a name.
+func VMName(vmID ids.ID) string {
+	b := bytes.TrimRight(vmID[:], "\x00")
+	if len(b) == 0 {
+		return ""
+	}
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return ""
+		}
+	}
+	return string(b)
+}
+
 func MkDirWithTimestamp(dirPrefix string) (string, error) {
 	currentTime := time.Now().Format(dirTimestampFormat)
 	dirName := dirPrefix + "_" + currentTime
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,3 +124,11 @@ func TestCheckPluginPaths(t *testing.T) {
 		require.Equal(t, tv.expectedErr, err, fmt.Sprintf("[%d] unexpected error", i))
 	}
 }
+
+func TestVMName(t *testing.T) {
+	vmID, err := VMID("subnetevm")
+	require.NoError(t, err)
+	require.Equal(t, "subnetevm", VMName(vmID))
+	require.Empty(t, VMName(ids.Empty))
+	require.Empty(t, VMName(ids.GenerateTestID()))
+}